@@ -4,11 +4,16 @@ import (
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"net/url"
 	"os"
 	"os/user"
 	"path"
+	"sort"
+	"strings"
 	"sync"
 
+	"github.com/metrue/fx/pkg/failure"
+	"github.com/metrue/fx/types"
 	"github.com/metrue/fx/utils"
 	"github.com/mitchellh/go-homedir"
 	"gopkg.in/yaml.v2"
@@ -16,6 +21,8 @@ import (
 
 // Items data of config file
 type Items struct {
+	// Version version of config file, config file of an older version is migrated on loading
+	Version      int                          `json:"version"`
 	Clouds       map[string]map[string]string `json:"clouds"`
 	CurrentCloud string                       `json:"current_cloud"`
 }
@@ -37,38 +44,74 @@ func LoadDefault() (*Config, error) {
 		configFile = os.Getenv("FX_CONFIG")
 
 	}
-	if _, err := os.Stat(configFile); os.IsNotExist(err) {
-		if err := utils.EnsureFile(configFile); err != nil {
-			return nil, err
-		}
-		if err := writeDefaultConfig(configFile); err != nil {
-			return nil, err
-		}
-	}
-	return load(configFile)
+	return Load(configFile)
 }
 
-// Load config
+// Load config, a default one is written when config file does not exist,
+// and config written by a previous version of fx is migrated
 func Load(configFile string) (*Config, error) {
 	if configFile == "" {
 		return nil, fmt.Errorf("invalid config file")
 	}
+	if err := utils.EnsureDir(path.Dir(configFile)); err != nil {
+		return nil, err
+	}
+
+	unlock, err := Lock(configFile)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
 
 	if _, err := os.Stat(configFile); os.IsNotExist(err) {
-		if err := utils.EnsureFile(configFile); err != nil {
+		if err := writeDefaultConfig(configFile); err != nil {
 			return nil, err
 		}
-		if err := writeDefaultConfig(configFile); err != nil {
+	}
+	c, err := load(configFile)
+	if err != nil {
+		return nil, err
+	}
+	if c.Version != Version {
+		if err := migrate(c); err != nil {
+			return nil, err
+		}
+		if err := writeItems(configFile, &c.Items); err != nil {
 			return nil, err
 		}
 	}
-	return load(configFile)
+	return c, nil
+}
+
+// update apply fn to the latest items in config file and save them, config file is locked meanwhile,
+// so that updates of concurrent fx processes are not lost
+func (c *Config) update(fn func(items *Items) error) error {
+	unlock, err := Lock(c.configFile)
+	if err != nil {
+		return failure.Config(err)
+	}
+	defer unlock()
+
+	latest, err := load(c.configFile)
+	if err != nil {
+		return failure.Config(err)
+	}
+	if err := fn(&latest.Items); err != nil {
+		return err
+	}
+	if err := writeItems(c.configFile, &latest.Items); err != nil {
+		return failure.Config(err)
+	}
+	c.Items = latest.Items
+	return nil
 }
 
 // AddCloud add a cloud
 func (c *Config) addCloud(name string, cloud map[string]string) error {
-	c.Items.Clouds[name] = cloud
-	return save(c)
+	return c.update(func(items *Items) error {
+		items.Clouds[name] = cloud
+		return nil
+	})
 }
 
 // AddDockerCloud add docker cloud
@@ -87,6 +130,10 @@ func (c *Config) AddDockerCloud(name string, config []byte) error {
 		"host": conf["ip"],
 		"user": conf["user"],
 	}
+	// docker engine is exposed by the legacy fx-agent
+	if conf["agent"] == "true" {
+		cloud["agent"] = "true"
+	}
 	return c.addCloud(name, cloud)
 }
 
@@ -97,11 +144,8 @@ func (c *Config) AddK8SCloud(name string, kubeconfig []byte) error {
 
 	dir := path.Dir(c.configFile)
 	kubecfg := path.Join(dir, name+".kubeconfig")
-	if err := utils.EnsureFile(kubecfg); err != nil {
-		return err
-	}
-	if err := ioutil.WriteFile(kubecfg, kubeconfig, 0666); err != nil {
-		return err
+	if err := WriteFile(kubecfg, kubeconfig); err != nil {
+		return failure.Config(err)
 	}
 
 	cloud := map[string]string{
@@ -112,23 +156,265 @@ func (c *Config) AddK8SCloud(name string, kubeconfig []byte) error {
 	return c.addCloud(name, cloud)
 }
 
+// AddPodmanCloud add podman cloud, socket is the unix socket of Podman service,
+// the default socket of Podman is used when it's empty
+func (c *Config) AddPodmanCloud(name string, socket string) error {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	cloud := map[string]string{
+		"type":   CloudTypePodman,
+		"socket": socket,
+	}
+	return c.addCloud(name, cloud)
+}
+
+// SetNamespace set the default namespace of a k8s cloud, services are deployed into it
+// when no namespace is given by command line
+func (c *Config) SetNamespace(name string, namespace string) error {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	return c.setOptions(name, "namespace", map[string]string{"namespace": namespace}, CloudTypeK8S)
+}
+
+// SetRegistry set the registry of a k8s or docker cloud, images are pushed to it and deployed by digest when it's set,
+// images of a k8s cloud are built in cluster. registrySecret is the name of docker config json secret in cluster
+// to push and pull images, it's optional and supported by k8s cloud only
+func (c *Config) SetRegistry(name string, registry string, registrySecret string) error {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	if registrySecret != "" {
+		return c.setOptions(name, "registry secret", map[string]string{
+			"registry":        registry,
+			"registry_secret": registrySecret,
+		}, CloudTypeK8S)
+	}
+	return c.setOptions(name, "registry", map[string]string{
+		"registry":        registry,
+		"registry_secret": "",
+	}, CloudTypeK8S, CloudTypeDocker, CloudTypePodman)
+}
+
+// SetDockerEndpoint set the endpoint of docker engine of a docker cloud, it's 'unix://' or 'tcp://' endpoint,
+// client certificates are required by a 'tcp://' endpoint, docker engine is reached by its unix socket,
+// through SSH for a remote host, when endpoint is empty
+func (c *Config) SetDockerEndpoint(name string, endpoint string, tlsCACert string, tlsCert string, tlsKey string) error {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	return c.setOptions(name, "endpoint", map[string]string{
+		"endpoint":   endpoint,
+		"tls_cacert": tlsCACert,
+		"tls_cert":   tlsCert,
+		"tls_key":    tlsKey,
+	}, CloudTypeDocker)
+}
+
+// SetIgnoreHostKey set whether host key of a docker cloud is verified when docker engine is reached through SSH,
+// it's verified with known hosts unless it's ignored explicitly
+func (c *Config) SetIgnoreHostKey(name string, ignore bool) error {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	value := ""
+	if ignore {
+		value = "true"
+	}
+	return c.setOptions(name, "ignoring host key", map[string]string{
+		"insecure_ignore_host_key": value,
+	}, CloudTypeDocker)
+}
+
+// setOptions set options of cloud with name, feature is only supported by cloudTypes
+func (c *Config) setOptions(name string, feature string, options map[string]string, cloudTypes ...string) error {
+	return c.update(func(items *Items) error {
+		cloud, ok := items.Clouds[name]
+		if !ok {
+			return failure.NotFound(fmt.Errorf("no cloud with name = %s", name))
+		}
+		if !contains(cloudTypes, cloud["type"]) {
+			return failure.Usage(fmt.Errorf("%s is only supported by %s cloud, but %s is %s", feature, strings.Join(cloudTypes, " or "), name, cloud["type"]))
+		}
+		for k, v := range options {
+			if v == "" {
+				delete(cloud, k)
+			} else {
+				cloud[k] = v
+			}
+		}
+		return nil
+	})
+}
+
 // Use set cloud instance with name as current context
 func (c *Config) Use(name string) error {
 	c.mux.Lock()
 	defer c.mux.Unlock()
 
-	has := false
-	for n := range c.Clouds {
-		if n == name {
-			has = true
-			break
+	return c.update(func(items *Items) error {
+		if _, ok := items.Clouds[name]; !ok {
+			return failure.NotFound(fmt.Errorf("no cloud with name = %s", name))
+		}
+		items.CurrentCloud = name
+		return nil
+	})
+}
+
+// Remove a cloud and the kubeconfig file written for it, the current cloud is removed only when force,
+// then the first of the others by name becomes the current one
+func (c *Config) Remove(name string, force bool) error {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	return c.update(func(items *Items) error {
+		cloud, ok := items.Clouds[name]
+		if !ok {
+			return failure.NotFound(fmt.Errorf("no cloud with name = %s", name))
+		}
+		if name == items.CurrentCloud && !force {
+			return failure.Usage(fmt.Errorf("%s is the current cloud, use another one first or remove it with --force", name))
+		}
+
+		if kubeconfig := cloud["kubeconfig"]; kubeconfig != "" && c.ownsFile(kubeconfig) {
+			if err := os.Remove(kubeconfig); err != nil && !os.IsNotExist(err) {
+				return failure.Config(err)
+			}
+		}
+		delete(items.Clouds, name)
+		if name == items.CurrentCloud {
+			items.CurrentCloud = ""
+			names := []string{}
+			for n := range items.Clouds {
+				names = append(names, n)
+			}
+			sort.Strings(names)
+			if len(names) > 0 {
+				items.CurrentCloud = names[0]
+			}
+		}
+		return nil
+	})
+}
+
+// Rename a cloud, the kubeconfig file written for it is renamed as well
+func (c *Config) Rename(name string, newName string) error {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	return c.update(func(items *Items) error {
+		cloud, ok := items.Clouds[name]
+		if !ok {
+			return failure.NotFound(fmt.Errorf("no cloud with name = %s", name))
+		}
+		if newName == "" {
+			return failure.Usage(fmt.Errorf("new name required"))
+		}
+		if _, ok := items.Clouds[newName]; ok {
+			return failure.Usage(fmt.Errorf("cloud %s already exists", newName))
+		}
+
+		if kubeconfig := cloud["kubeconfig"]; kubeconfig != "" && c.ownsFile(kubeconfig) {
+			kubecfg := path.Join(path.Dir(c.configFile), newName+".kubeconfig")
+			if err := os.Rename(kubeconfig, kubecfg); err != nil {
+				return failure.Config(err)
+			}
+			cloud["kubeconfig"] = kubecfg
+		}
+		delete(items.Clouds, name)
+		items.Clouds[newName] = cloud
+		if items.CurrentCloud == name {
+			items.CurrentCloud = newName
+		}
+		return nil
+	})
+}
+
+// ownsFile check if file is written by fx next to config file, e.g. kubeconfig of a k8s cloud
+func (c *Config) ownsFile(file string) bool {
+	return path.Dir(file) == path.Dir(c.configFile) && strings.HasSuffix(file, ".kubeconfig")
+}
+
+// Infras infrastructures in config, sorted by name
+func (c *Config) Infras() []types.Infra {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	infras := []types.Infra{}
+	for name, cloud := range c.Clouds {
+		infras = append(infras, c.infraOf(name, cloud))
+	}
+	sort.Slice(infras, func(i, j int) bool {
+		return infras[i].Name < infras[j].Name
+	})
+	return infras
+}
+
+// Infra details of a cloud, with all its settings, credentials in them are redacted
+func (c *Config) Infra(name string) (types.Infra, error) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	cloud, ok := c.Clouds[name]
+	if !ok {
+		return types.Infra{}, failure.NotFound(fmt.Errorf("no cloud with name = %s", name))
+	}
+	infra := c.infraOf(name, cloud)
+	infra.Settings = Redact(cloud)
+	return infra, nil
+}
+
+func (c *Config) infraOf(name string, cloud map[string]string) types.Infra {
+	infra := types.Infra{
+		Name:       name,
+		Type:       cloud["type"],
+		Current:    name == c.CurrentCloud,
+		Host:       cloud["host"],
+		Endpoint:   redactURL(cloud["endpoint"]),
+		User:       cloud["user"],
+		Kubeconfig: cloud["kubeconfig"],
+		Namespace:  cloud["namespace"],
+		Registry:   redactURL(cloud["registry"]),
+	}
+	if cloud["type"] == CloudTypePodman {
+		infra.Endpoint = cloud["socket"]
+	}
+	return infra
+}
+
+// Redacted replacement of credentials
+const Redacted = "REDACTED"
+
+// Redact a copy of settings of a cloud, values of credentials are replaced with Redacted,
+// and so are passwords in URLs
+func Redact(cloud map[string]string) map[string]string {
+	redacted := map[string]string{}
+	for k, v := range cloud {
+		key := strings.ToLower(k)
+		if strings.Contains(key, "password") ||
+			strings.Contains(key, "token") ||
+			strings.Contains(key, "credential") ||
+			strings.HasSuffix(key, "auth") {
+			redacted[k] = Redacted
+		} else {
+			redacted[k] = redactURL(v)
 		}
 	}
-	if !has {
-		return fmt.Errorf("no cloud with name = %s", name)
+	return redacted
+}
+
+// redactURL replace password in URL with Redacted, other values are returned as they are
+func redactURL(v string) string {
+	u, err := url.Parse(v)
+	if err != nil || u.User == nil {
+		return v
 	}
-	c.Items.CurrentCloud = name
-	return save(c)
+	if _, ok := u.User.Password(); !ok {
+		return v
+	}
+	u.User = url.UserPassword(u.User.Username(), Redacted)
+	return u.String()
 }
 
 // View view current config
@@ -148,6 +434,9 @@ func load(configFile string) (*Config, error) {
 	if err := yaml.Unmarshal(conf, &items); err != nil {
 		return nil, err
 	}
+	if items.Clouds == nil {
+		items.Clouds = map[string]map[string]string{}
+	}
 	var c = Config{
 		configFile: configFile,
 		Items:      items,
@@ -155,15 +444,13 @@ func load(configFile string) (*Config, error) {
 	return &c, nil
 }
 
-func save(c *Config) error {
-	conf, err := yaml.Marshal(c.Items)
+// writeItems write items into config file atomically
+func writeItems(configFile string, items *Items) error {
+	body, err := yaml.Marshal(items)
 	if err != nil {
 		return err
 	}
-	if err := ioutil.WriteFile(c.configFile, conf, 0666); err != nil {
-		return err
-	}
-	return nil
+	return WriteFile(configFile, body)
 }
 
 func writeDefaultConfig(configFile string) error {
@@ -172,6 +459,7 @@ func writeDefaultConfig(configFile string) error {
 		return err
 	}
 	items := Items{
+		Version: Version,
 		Clouds: map[string]map[string]string{
 			"default": map[string]string{
 				"type": "docker",
@@ -181,15 +469,14 @@ func writeDefaultConfig(configFile string) error {
 		},
 		CurrentCloud: "default",
 	}
+	return writeItems(configFile, &items)
+}
 
-	body, err := yaml.Marshal(items)
-	if err != nil {
-		return err
-	}
-
-	if err := ioutil.WriteFile(configFile, body, 0666); err != nil {
-		return err
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
 	}
-
-	return nil
+	return false
 }
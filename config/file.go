@@ -0,0 +1,53 @@
+package config
+
+import (
+	"io/ioutil"
+	"os"
+	"path"
+)
+
+// WriteFile write data into file atomically by renaming a temporary file written next to it, so that
+// a concurrent reader never sees a partial file. file is readable and writable by owner only,
+// since config file and kubeconfig files hold credentials
+func WriteFile(file string, data []byte) error {
+	tmp, err := ioutil.TempFile(path.Dir(file), "."+path.Base(file)+".")
+	if err != nil {
+		return err
+	}
+	// nolint: errcheck
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Chmod(0600); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), file)
+}
+
+// Lock file exclusively across processes with a lock file next to it, until unlock is called
+func Lock(file string) (unlock func(), err error) {
+	f, err := os.OpenFile(file+".lock", os.O_CREATE|os.O_RDWR, 0600)
+	if err != nil {
+		return nil, err
+	}
+	if err := lockFile(f); err != nil {
+		f.Close()
+		return nil, err
+	}
+	return func() {
+		// nolint: errcheck
+		unlockFile(f)
+		f.Close()
+	}, nil
+}
@@ -0,0 +1,38 @@
+package config
+
+import (
+	"fmt"
+	"os"
+)
+
+// Version version of config file written by this version of fx
+const Version = 1
+
+// migrations migrations[i] migrates config of version i to version i + 1
+var migrations = []func(c *Config) error{
+	// config file and kubeconfig files were written with 0666, they hold credentials
+	func(c *Config) error {
+		for _, cloud := range c.Clouds {
+			if kubeconfig := cloud["kubeconfig"]; kubeconfig != "" && c.ownsFile(kubeconfig) {
+				if err := os.Chmod(kubeconfig, 0600); err != nil && !os.IsNotExist(err) {
+					return err
+				}
+			}
+		}
+		return nil
+	},
+}
+
+// migrate config to Version, config of a newer version is not supported
+func migrate(c *Config) error {
+	if c.Version > Version {
+		return fmt.Errorf("config file %s is of version %d, please upgrade fx to use it", c.configFile, c.Version)
+	}
+	for v := c.Version; v < Version; v++ {
+		if err := migrations[v](c); err != nil {
+			return fmt.Errorf("could not migrate config file %s to version %d: %v", c.configFile, v+1, err)
+		}
+		c.Version = v + 1
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+package config
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+)
+
+func TestMigrate(t *testing.T) {
+	dir, err := ioutil.TempDir("", "fx-config")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	configFile := path.Join(dir, "config.yml")
+	kubeconfig := path.Join(dir, "k8s-1.kubeconfig")
+	if err := ioutil.WriteFile(kubeconfig, []byte("sample kubeconfig"), 0666); err != nil {
+		t.Fatal(err)
+	}
+	// config written by a version of fx without version field
+	legacy := fmt.Sprintf(`clouds:
+  k8s-1:
+    kubeconfig: %s
+    type: k8s
+currentcloud: k8s-1
+`, kubeconfig)
+	if err := ioutil.WriteFile(configFile, []byte(legacy), 0666); err != nil {
+		t.Fatal(err)
+	}
+
+	c, err := Load(configFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Version != Version {
+		t.Fatalf("should get %d but got %d", Version, c.Version)
+	}
+	if c.CurrentCloud != "k8s-1" || c.Clouds["k8s-1"]["kubeconfig"] != kubeconfig {
+		t.Fatalf("should keep clouds but got %v", c.Items)
+	}
+	for _, file := range []string{configFile, kubeconfig} {
+		info, err := os.Stat(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if info.Mode().Perm() != 0600 {
+			t.Fatalf("should get %v but got %v", os.FileMode(0600), info.Mode().Perm())
+		}
+	}
+
+	c, err = Load(configFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Version != Version {
+		t.Fatalf("should get %d but got %d", Version, c.Version)
+	}
+
+	if err := ioutil.WriteFile(configFile, []byte(fmt.Sprintf("version: %d\n", Version+1)), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Load(configFile); err == nil {
+		t.Fatal("should get error of config file of a newer version")
+	}
+}
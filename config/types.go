@@ -5,3 +5,6 @@ const CloudTypeDocker = "docker"
 
 // CloudTypeK8S k8s type
 const CloudTypeK8S = "k8s"
+
+// CloudTypePodman podman type
+const CloudTypePodman = "podman"
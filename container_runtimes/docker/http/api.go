@@ -1,9 +1,9 @@
 package api
 
 import (
-	"bufio"
 	"bytes"
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
@@ -15,7 +15,6 @@ import (
 	"strings"
 	"time"
 
-	"github.com/apex/log"
 	dockerTypes "github.com/docker/docker/api/types"
 	"github.com/docker/docker/api/types/container"
 	dockerTypesContainer "github.com/docker/docker/api/types/container"
@@ -24,6 +23,9 @@ import (
 	"github.com/google/go-querystring/query"
 	"github.com/google/uuid"
 	containerruntimes "github.com/metrue/fx/container_runtimes"
+	dockerOptions "github.com/metrue/fx/container_runtimes/docker/options"
+	"github.com/metrue/fx/container_runtimes/docker/progress"
+	"github.com/metrue/fx/pkg/failure"
 	"github.com/metrue/fx/types"
 	"github.com/metrue/fx/utils"
 	"github.com/pkg/errors"
@@ -33,33 +35,45 @@ import (
 type API struct {
 	endpoint string
 	version  string
+
+	// transport to reach docker engine, http.DefaultTransport is used when it's nil
+	transport http.RoundTripper
 }
 
-// Create a API
+// Create a API talks to docker engine exposed on host:port without TLS, e.g. by the legacy fx-agent
 func Create(host string, port string) (*API, error) {
-	addr := host + ":" + port
-	v, err := version(addr)
-	if err != nil {
-		return nil, err
-	}
-	endpoint := fmt.Sprintf("http://%s:%s/v%s", host, port, v)
-	return &API{
-		endpoint: endpoint,
-		version:  v,
-	}, nil
+	return connect(fmt.Sprintf("http://%s:%s", host, port), nil)
 }
 
 // MustCreate a api object, panic if not
 func MustCreate(host string, port string) *API {
-	addr := host + ":" + port
-	v, err := version(addr)
+	api, err := Create(host, port)
 	if err != nil {
 		panic(err)
 	}
-	endpoint := fmt.Sprintf("http://%s:%s/v%s", host, port, v)
-	return &API{
-		endpoint: endpoint,
-		version:  v,
+	return api
+}
+
+// connect to docker engine on endpoint, API version of docker engine is negotiated
+func connect(endpoint string, transport http.RoundTripper) (*API, error) {
+	api := &API{
+		endpoint:  endpoint,
+		transport: transport,
+	}
+	v, err := api.Version(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	api.endpoint = fmt.Sprintf("%s/v%s", endpoint, v)
+	api.version = v
+	return api, nil
+}
+
+// httpClient a client to send requests to docker engine, no timeout when timeout is 0
+func (api *API) httpClient(timeout time.Duration) *http.Client {
+	return &http.Client{
+		Transport: api.transport,
+		Timeout:   timeout,
 	}
 }
 
@@ -76,17 +90,20 @@ func (api *API) get(path string, qs string, v interface{}) error {
 	if err != nil {
 		return err
 	}
-	client := &http.Client{Timeout: 20 * time.Second}
+	client := api.httpClient(20 * time.Second)
 	resp, err := client.Do(req)
 	if err != nil {
 		return err
 	}
 
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		return failure.NotFound(fmt.Errorf("request %s failed: %d - %s", url, resp.StatusCode, resp.Status))
+	}
 	if resp.StatusCode != 200 {
 		return fmt.Errorf("request %s failed: %d - %s", url, resp.StatusCode, resp.Status)
 	}
 
-	defer resp.Body.Close()
 	body, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
 		return err
@@ -108,22 +125,23 @@ func (api *API) post(path string, body []byte, expectStatus int, v interface{})
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Accept", "application/json")
 
-	client := &http.Client{Timeout: 20 * time.Second}
+	client := api.httpClient(20 * time.Second)
 	resp, err := client.Do(req)
 	if err != nil {
 		return err
 	}
 
-	if resp.StatusCode != expectStatus {
-		return fmt.Errorf("request %s (%s) failed: %d - %s", url, string(body), resp.StatusCode, resp.Status)
-	}
-
 	defer resp.Body.Close()
 	b, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
 		return err
 	}
 
+	// request body is not in the error, it could have environment variables and secrets of container
+	if resp.StatusCode != expectStatus {
+		return fmt.Errorf("request %s failed: %d - %s", url, resp.StatusCode, strings.TrimSpace(string(b)))
+	}
+
 	err = json.Unmarshal(b, &v)
 	if err != nil {
 		return err
@@ -133,11 +151,7 @@ func (api *API) post(path string, body []byte, expectStatus int, v interface{})
 
 // Version get version of docker engine
 func (api *API) Version(ctx context.Context) (string, error) {
-	return version(api.endpoint)
-}
-
-func version(endpoint string) (string, error) {
-	path := endpoint + "/version"
+	path := api.endpoint + "/version"
 	if !strings.HasPrefix(path, "http") {
 		path = "http://" + path
 	}
@@ -146,7 +160,8 @@ func version(endpoint string) (string, error) {
 	if err != nil {
 		return "", err
 	}
-	client := &http.Client{Timeout: 20 * time.Second}
+	req = req.WithContext(ctx)
+	client := api.httpClient(20 * time.Second)
 	resp, err := client.Do(req)
 	if err != nil {
 		return "", err
@@ -178,18 +193,29 @@ func (api *API) ListContainer(ctx context.Context, name string) ([]types.Service
 			return []types.Service{}, err
 		}
 
-		port, err := strconv.Atoi(info.HostConfig.PortBindings["3000/tcp"][0].HostPort)
+		var binding *nat.PortBinding
+		for _, bindings := range info.HostConfig.PortBindings {
+			if len(bindings) > 0 {
+				binding = &bindings[0]
+				break
+			}
+		}
+		if binding == nil {
+			return []types.Service{}, fmt.Errorf("container %s binds no host port", name)
+		}
+		port, err := strconv.Atoi(binding.HostPort)
 		if err != nil {
 			return []types.Service{}, err
 		}
 		return []types.Service{
 			types.Service{
-				Name:  name,
-				Image: info.Image,
-				State: info.State.Status,
-				ID:    info.ID,
-				Host:  info.HostConfig.PortBindings["3000/tcp"][0].HostIP,
-				Port:  port,
+				Name:    name,
+				Image:   info.Image,
+				State:   info.State.Status,
+				ID:      info.ID,
+				Host:    binding.HostIP,
+				Port:    port,
+				Created: info.Created,
 			},
 		}, nil
 	}
@@ -229,15 +255,24 @@ func (api *API) ListContainer(ctx context.Context, name string) ([]types.Service
 	for _, container := range containers {
 		// container name have extra forward slash
 		// https://github.com/moby/moby/issues/6705
-		if strings.HasPrefix(container.Names[0], fmt.Sprintf("/%s", name)) {
-			svs[container.Image] = types.Service{
-				Name:  container.Names[0],
-				Image: container.Image,
-				ID:    container.ID,
-				Host:  container.Ports[0].IP,
-				Port:  int(container.Ports[0].PublicPort),
-				State: container.State,
+		if !strings.HasPrefix(container.Names[0], fmt.Sprintf("/%s", name)) {
+			continue
+		}
+		// containers binding no host port are not services, e.g. the ones behind port proxies
+		for _, port := range container.Ports {
+			if port.PublicPort == 0 {
+				continue
+			}
+			svs[container.Names[0]] = types.Service{
+				Name:    container.Names[0],
+				Image:   container.Image,
+				ID:      container.ID,
+				Host:    port.IP,
+				Port:    int(port.PublicPort),
+				State:   container.State,
+				Created: time.Unix(container.Created, 0),
 			}
+			break
 		}
 	}
 	services := []types.Service{}
@@ -249,7 +284,7 @@ func (api *API) ListContainer(ctx context.Context, name string) ([]types.Service
 }
 
 // BuildImage build image
-func (api *API) BuildImage(ctx context.Context, workdir string, name string) error {
+func (api *API) BuildImage(ctx context.Context, workdir string, name string, buildArgs map[string]string) error {
 	tarDir, err := ioutil.TempDir("/tmp", "fx-tar")
 	if err != nil {
 		return err
@@ -273,6 +308,7 @@ func (api *API) BuildImage(ctx context.Context, workdir string, name string) err
 		Labels     string `url:"labels,omitempty"`
 		Tags       string `url:"t,omitempty"`
 		Dockerfile string `url:"dockerfile,omitempty"`
+		BuildArgs  string `url:"buildargs,omitempty"`
 	}
 
 	// Apply default labels
@@ -283,6 +319,13 @@ func (api *API) BuildImage(ctx context.Context, workdir string, name string) err
 		Labels:     string(labelsJSON),
 		Dockerfile: "Dockerfile",
 	}
+	if len(buildArgs) > 0 {
+		buildArgsJSON, err := json.Marshal(buildArgs)
+		if err != nil {
+			return err
+		}
+		q.BuildArgs = string(buildArgsJSON)
+	}
 
 	qs, err := query.Values(q)
 	if err != nil {
@@ -298,42 +341,71 @@ func (api *API) BuildImage(ctx context.Context, workdir string, name string) err
 		return err
 	}
 
+	req = req.WithContext(ctx)
 	req.Header.Set("Content-Type", "application/x-tar")
-	client := &http.Client{Timeout: 600 * time.Second}
+	client := api.httpClient(600 * time.Second)
 	resp, err := client.Do(req)
 	if err != nil {
 		return err
 	}
 
 	defer resp.Body.Close()
-	scanner := bufio.NewScanner(resp.Body)
 
-	for scanner.Scan() {
-		if os.Getenv("DEBUG") != "" {
-			log.Infof(scanner.Text())
-		}
+	if resp.StatusCode != http.StatusOK {
+		body, _ := ioutil.ReadAll(resp.Body)
+		return fmt.Errorf("request %s failed: %d - %s", url, resp.StatusCode, strings.TrimSpace(string(body)))
 	}
-	if err := scanner.Err(); err != nil {
-		return err
-	}
-	return nil
+	// build failure is reported in message stream with 200 status code
+	return progress.Decode(resp.Body, progress.Report("building"))
 }
 
-// PushImage push a image
-func (api *API) PushImage(ctx context.Context, name string) (string, error) {
-	return "", nil
+// PushImage push image with name of registry in it, e.g. 'registry.example.com/team/hello:latest',
+// registryAuth is credentials of registry encoded as X-Registry-Auth header, digest of pushed image is returned
+func (api *API) PushImage(ctx context.Context, name string, registryAuth string) (string, error) {
+	repo, tag := containerruntimes.SplitTag(name)
+	query := url.Values{}
+	query.Set("tag", tag)
+	url := fmt.Sprintf("%s/images/%s/push?%s", api.endpoint, repo, query.Encode())
+	req, err := http.NewRequest("POST", url, nil)
+	if err != nil {
+		return "", err
+	}
+	req = req.WithContext(ctx)
+	// docker engine rejects a push without X-Registry-Auth header, even to a registry needs no credentials
+	if registryAuth == "" {
+		registryAuth = base64.URLEncoding.EncodeToString([]byte("{}"))
+	}
+	req.Header.Set("X-Registry-Auth", registryAuth)
+
+	client := api.httpClient(0)
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return "", failure.NotFound(fmt.Errorf("no such image: %s", name))
+	}
+	if resp.StatusCode != http.StatusOK {
+		body, _ := ioutil.ReadAll(resp.Body)
+		return "", fmt.Errorf("request %s failed: %d - %s", url, resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+	// push failure is reported in message stream with 200 status code
+	return progress.Pushed(resp.Body)
 }
 
 // InspectImage inspect image
 func (api *API) InspectImage(ctx context.Context, name string, image interface{}) error {
-	return nil
+	return api.get(fmt.Sprintf("/images/%s/json", name), "", image)
 }
 
-// TagImage tag image
+// TagImage tag image with tag, a reference with or without tag, e.g. 'registry.example.com:5000/hello:v1'
 func (api *API) TagImage(ctx context.Context, name string, tag string) error {
+	repo, t := containerruntimes.SplitTag(tag)
 	query := url.Values{}
-	query.Set("repo", name)
-	query.Set("tag", tag)
+	query.Set("repo", repo)
+	query.Set("tag", t)
 	path := fmt.Sprintf("/images/%s/tag?%s", name, query.Encode())
 
 	url := fmt.Sprintf("%s%s", api.endpoint, path)
@@ -341,17 +413,53 @@ func (api *API) TagImage(ctx context.Context, name string, tag string) error {
 	if err != nil {
 		return err
 	}
+	req = req.WithContext(ctx)
+
+	client := api.httpClient(10 * time.Second)
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return failure.NotFound(fmt.Errorf("no such image: %s", name))
+	}
+	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
+		body, _ := ioutil.ReadAll(resp.Body)
+		return fmt.Errorf("request %s failed: %d - %s", url, resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+	return nil
+}
 
-	client := &http.Client{Timeout: 10 * time.Second}
-	if _, err = client.Do(req); err != nil {
+// RemoveImage remove image, images of stopped containers are removed as well
+func (api *API) RemoveImage(ctx context.Context, name string) error {
+	url := fmt.Sprintf("%s/images/%s?force=true", api.endpoint, name)
+	req, err := http.NewRequest("DELETE", url, nil)
+	if err != nil {
 		return err
 	}
+	req = req.WithContext(ctx)
 
+	client := api.httpClient(10 * time.Second)
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return failure.NotFound(fmt.Errorf("no such image: %s", name))
+	}
+	if resp.StatusCode != http.StatusOK {
+		body, _ := ioutil.ReadAll(resp.Body)
+		return fmt.Errorf("request %s failed: %d - %s", url, resp.StatusCode, strings.TrimSpace(string(body)))
+	}
 	return nil
 }
 
 // StartContainer start container
-func (api *API) StartContainer(ctx context.Context, name string, image string, bindings []types.PortBinding) error {
+func (api *API) StartContainer(ctx context.Context, name string, image string, bindings []types.PortBinding, options types.DeployOptions) error {
 	networks, err := api.GetNetwork(fxNetworkName)
 	if err != nil {
 		return errors.Wrapf(err, "get network failed: %s", err)
@@ -364,8 +472,10 @@ func (api *API) StartContainer(ctx context.Context, name string, image string, b
 	}
 	networks, _ = api.GetNetwork(fxNetworkName)
 
+	// container is reachable by its name from other containers on fx-net, e.g. fx gateway
 	endpoint := &network.EndpointSettings{
 		NetworkID: networks[0].ID,
+		Aliases:   []string{name},
 	}
 	networkConfig := &network.NetworkingConfig{
 		EndpointsConfig: map[string]*network.EndpointSettings{
@@ -384,17 +494,23 @@ func (api *API) StartContainer(ctx context.Context, name string, image string, b
 		}
 		port := nat.Port(fmt.Sprintf("%d/tcp", binding.ContainerExposePort))
 		portSet[port] = struct{}{}
-		portMap[port] = bindings
+		// several host ports could be bound to the same container port
+		portMap[port] = append(portMap[port], bindings...)
 	}
 	config := &dockerTypesContainer.Config{
 		Image:        image,
 		ExposedPorts: portSet,
+		Env:          options.EnvList(),
 	}
 
+	// container is not auto removed so that its logs are still available after it crashes,
+	// StopContainer removes it
 	hostConfig := &dockerTypesContainer.HostConfig{
-		AutoRemove:   true,
 		PortBindings: portMap,
 	}
+	if err := dockerOptions.Apply(config, hostConfig, options); err != nil {
+		return err
+	}
 
 	req := ContainerCreateRequestPayload{
 		Config:           config,
@@ -425,7 +541,7 @@ func (api *API) StartContainer(ctx context.Context, name string, image string, b
 	if err != nil {
 		return errors.Wrap(err, "error new container create request")
 	}
-	client := &http.Client{Timeout: 20 * time.Second}
+	client := api.httpClient(20 * time.Second)
 	resp, err := client.Do(request)
 	if err != nil {
 		return errors.Wrap(err, "error do start container request")
@@ -450,9 +566,12 @@ func (api *API) StartContainer(ctx context.Context, name string, image string, b
 	return nil
 }
 
-// StopContainer stop a container
+// StopContainer stop and remove a container
 func (api *API) StopContainer(ctx context.Context, name string) error {
-	return api.Stop(name)
+	if err := api.Stop(name); err != nil {
+		return err
+	}
+	return api.Remove(name)
 }
 
 // InspectContainer inspect container
@@ -0,0 +1,32 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestBuildImage(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/build" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(`{"stream":"Step 1/2 : FROM metrue/fx-node-base\n"}
+{"stream":"Step 2/2 : RUN npm install\n"}
+{"errorDetail":{"message":"returned a non-zero code: 1"},"error":"returned a non-zero code: 1"}
+`))
+	}))
+	defer server.Close()
+
+	api := &API{endpoint: server.URL}
+	err := api.BuildImage(context.Background(), "../fixture", "fx-build-test", nil)
+	if err == nil {
+		t.Fatalf("should get error when build failed")
+	}
+	if !strings.Contains(err.Error(), "Step 2/2 : RUN npm install") {
+		t.Fatalf("should get failing step in error but got %s", err)
+	}
+}
@@ -0,0 +1,35 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// CopyToContainer extract a tar archive into the directory at path of a container
+func (api *API) CopyToContainer(ctx context.Context, name string, path string, content io.Reader) error {
+	query := url.Values{}
+	query.Set("path", path)
+	url := fmt.Sprintf("%s/containers/%s/archive?%s", api.endpoint, name, query.Encode())
+	req, err := http.NewRequest("PUT", url, content)
+	if err != nil {
+		return err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", "application/x-tar")
+
+	client := api.httpClient(20 * time.Second)
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("request %s failed: %d - %s", url, resp.StatusCode, resp.Status)
+	}
+	return nil
+}
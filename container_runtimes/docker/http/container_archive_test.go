@@ -0,0 +1,56 @@
+package api
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestCopyToContainer(t *testing.T) {
+	var received []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "PUT" || r.URL.Path != "/containers/fx-archive/archive" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if r.URL.Query().Get("path") != "/etc/fx" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		tr := tar.NewReader(r.Body)
+		if _, err := tr.Next(); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		received, _ = ioutil.ReadAll(tr)
+	}))
+	defer server.Close()
+
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	body := []byte("hello")
+	if err := tw.WriteHeader(&tar.Header{Name: "hello.txt", Mode: 0644, Size: int64(len(body))}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tw.Write(body); err != nil {
+		t.Fatal(err)
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	api := &API{endpoint: server.URL}
+	if err := api.CopyToContainer(context.Background(), "fx-archive", "/etc/fx", &buf); err != nil {
+		t.Fatal(err)
+	}
+	if string(received) != "hello" {
+		t.Fatalf("should get %s but got %s", "hello", string(received))
+	}
+	if err := api.CopyToContainer(context.Background(), "fx-missing", "/etc/fx", &buf); err == nil {
+		t.Fatalf("should get error when container not found")
+	}
+}
@@ -2,6 +2,7 @@ package api
 
 import (
 	"fmt"
+	"time"
 
 	dockerTypes "github.com/docker/docker/api/types"
 	"github.com/docker/docker/api/types/container"
@@ -11,6 +12,7 @@ type containerInfo struct {
 	ID         string                     `json:"Id"`
 	State      dockerTypes.ContainerState `json:"State"`
 	Image      string                     `json:"Image"`
+	Created    time.Time                  `json:"Created"`
 	HostConfig container.HostConfig       `json:"HostConfig"`
 }
 
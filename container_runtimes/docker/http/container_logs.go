@@ -0,0 +1,59 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	timetypes "github.com/docker/docker/api/types/time"
+	"github.com/docker/docker/pkg/stdcopy"
+	"github.com/metrue/fx/types"
+)
+
+// StreamContainerLogs write stdout and stderr of a container into w,
+// it blocks until container exits when options.Follow is set
+func (api *API) StreamContainerLogs(ctx context.Context, name string, options types.LogOptions, w io.Writer) error {
+	query := url.Values{}
+	query.Set("stdout", "1")
+	query.Set("stderr", "1")
+	if options.Follow {
+		query.Set("follow", "1")
+	}
+	if options.Since != "" {
+		ts, err := timetypes.GetTimestamp(options.Since, time.Now())
+		if err != nil {
+			return err
+		}
+		query.Set("since", ts)
+	}
+	if options.Tail != "" {
+		query.Set("tail", options.Tail)
+	}
+
+	path := fmt.Sprintf("/containers/%s/logs?%s", name, query.Encode())
+	url := fmt.Sprintf("%s%s", api.endpoint, path)
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return err
+	}
+	req = req.WithContext(ctx)
+
+	// no timeout here since logs could be followed as long as container running
+	client := api.httpClient(0)
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != 200 {
+		return fmt.Errorf("request %s failed: %d - %s", url, resp.StatusCode, resp.Status)
+	}
+
+	// fx containers are created without TTY, so stdout and stderr are multiplexed in one stream
+	_, err = stdcopy.StdCopy(w, w, resp.Body)
+	return err
+}
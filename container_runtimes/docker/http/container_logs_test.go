@@ -0,0 +1,41 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/docker/docker/pkg/stdcopy"
+	"github.com/metrue/fx/types"
+)
+
+func TestStreamContainerLogs(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/containers/fx-logs/logs" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if r.URL.Query().Get("tail") != "10" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		_, _ = stdcopy.NewStdWriter(w, stdcopy.Stdout).Write([]byte("hello\n"))
+		_, _ = stdcopy.NewStdWriter(w, stdcopy.Stderr).Write([]byte("world\n"))
+	}))
+	defer server.Close()
+
+	api := &API{endpoint: server.URL}
+	var buf bytes.Buffer
+	if err := api.StreamContainerLogs(context.Background(), "fx-logs", types.LogOptions{Tail: "10"}, &buf); err != nil {
+		t.Fatal(err)
+	}
+	if buf.String() != "hello\nworld\n" {
+		t.Fatalf("should get %q but got %q", "hello\nworld\n", buf.String())
+	}
+
+	if err := api.StreamContainerLogs(context.Background(), "not-exist", types.LogOptions{}, &buf); err == nil {
+		t.Fatal("should get error when container not found")
+	}
+}
@@ -16,24 +16,17 @@ import (
 // indicated by the given condition, either "not-running" (default),
 // "next-exit", or "removed".
 //
-// If this client's API version is before 1.30, condition is ignored and
-// ContainerWait will return immediately with the two channels, as the server
-// will wait as if the condition were "not-running".
-//
-// If this client's API version is at least 1.30, ContainerWait blocks until
-// the request has been acknowledged by the server (with a response header),
-// then returns two channels on which the caller can wait for the exit status
-// of the container or an error if there was a problem either beginning the
-// wait request or in getting the response. This allows the caller to
-// synchronize ContainerWait with other calls, such as specifying a
-// "next-exit" condition before issuing a ContainerStart request.
+// It returns immediately with two channels, on which the caller can wait for
+// the exit status of the container or an error if there was a problem either
+// making the wait request or in getting the response, exactly one of them
+// receives a value. The request is cancelled when ctx is done.
 func (api *API) ContainerWait(
 	ctx context.Context,
 	containerID string,
 	condition container.WaitCondition,
 	timeout time.Duration,
 ) (<-chan container.ContainerWaitOKBody, <-chan error) {
-	resultC := make(chan container.ContainerWaitOKBody)
+	resultC := make(chan container.ContainerWaitOKBody, 1)
 	errC := make(chan error, 1)
 
 	query := url.Values{}
@@ -46,31 +39,33 @@ func (api *API) ContainerWait(
 		errC <- err
 		return resultC, errC
 	}
+	request = request.WithContext(ctx)
 
-	client := &http.Client{Timeout: timeout}
-	if _, err = client.Do(request); err != nil {
-		errC <- err
-		return resultC, errC
-	}
-
+	// channels are buffered so that the goroutine never blocks when caller stops waiting
+	client := api.httpClient(timeout)
 	go func() {
 		resp, err := client.Do(request)
 		if err != nil {
 			errC <- err
+			return
 		}
-
 		defer resp.Body.Close()
 
-		var res container.ContainerWaitOKBody
 		body, err := ioutil.ReadAll(resp.Body)
 		if err != nil {
 			errC <- err
+			return
+		}
+		if resp.StatusCode != http.StatusOK {
+			errC <- fmt.Errorf("wait container %s failed: %s %s", containerID, resp.Status, body)
+			return
 		}
 
+		var res container.ContainerWaitOKBody
 		if err := json.Unmarshal(body, &res); err != nil {
 			errC <- err
+			return
 		}
-
 		resultC <- res
 	}()
 	return resultC, errC
@@ -0,0 +1,73 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/docker/docker/api/types/container"
+)
+
+func TestContainerWait(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/containers/hello/wait":
+			atomic.AddInt32(&requests, 1)
+			if r.Method != "POST" {
+				t.Errorf("should get %s but got %s", "POST", r.Method)
+			}
+			if r.URL.Query().Get("condition") != string(container.WaitConditionNotRunning) {
+				t.Errorf("should get %s but got %s", container.WaitConditionNotRunning, r.URL.Query().Get("condition"))
+			}
+			fmt.Fprint(w, `{"StatusCode":3}`)
+		case "/containers/slow/wait":
+			<-r.Context().Done()
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	api := &API{endpoint: server.URL}
+
+	t.Run("exited", func(t *testing.T) {
+		resultC, errC := api.ContainerWait(context.Background(), "hello", container.WaitConditionNotRunning, 5*time.Second)
+		select {
+		case res := <-resultC:
+			if res.StatusCode != 3 {
+				t.Fatalf("should get %d but got %d", 3, res.StatusCode)
+			}
+		case err := <-errC:
+			t.Fatal(err)
+		}
+		if n := atomic.LoadInt32(&requests); n != 1 {
+			t.Fatalf("should get %d but got %d", 1, n)
+		}
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		resultC, errC := api.ContainerWait(context.Background(), "nobody", container.WaitConditionNotRunning, 5*time.Second)
+		select {
+		case res := <-resultC:
+			t.Fatalf("should get error but got %v", res)
+		case <-errC:
+		}
+	})
+
+	t.Run("cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		resultC, errC := api.ContainerWait(ctx, "slow", container.WaitConditionNotRunning, 0)
+		cancel()
+		select {
+		case res := <-resultC:
+			t.Fatalf("should get error but got %v", res)
+		case <-errC:
+		case <-time.After(5 * time.Second):
+			t.Fatal("should stop waiting when context is cancelled")
+		}
+	})
+}
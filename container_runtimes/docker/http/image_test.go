@@ -0,0 +1,110 @@
+package api
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	dockerTypes "github.com/docker/docker/api/types"
+	"github.com/metrue/fx/pkg/failure"
+)
+
+// fakeImageEngine a docker engine stand-in with image localhost:5000/hello:latest in it,
+// which is pushed to a registry accepts user:pass only
+func fakeImageEngine(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/images/localhost:5000/hello/push":
+			if r.Method != "POST" {
+				t.Errorf("should get %s but got %s", "POST", r.Method)
+			}
+			if r.URL.Query().Get("tag") != "latest" {
+				t.Errorf("should get %s but got %s", "latest", r.URL.Query().Get("tag"))
+			}
+			var auth dockerTypes.AuthConfig
+			body, err := base64.URLEncoding.DecodeString(r.Header.Get("X-Registry-Auth"))
+			if err != nil {
+				t.Errorf("should get X-Registry-Auth header encoded but got %s", err)
+			}
+			_ = json.Unmarshal(body, &auth)
+			fmt.Fprintln(w, `{"status":"The push refers to repository [localhost:5000/hello]"}`)
+			if auth.Username != "user" || auth.Password != "pass" {
+				fmt.Fprintln(w, `{"errorDetail":{"message":"unauthorized: authentication required"},"error":"unauthorized: authentication required"}`)
+				return
+			}
+			fmt.Fprintln(w, `{"status":"Pushed","progressDetail":{},"id":"2a4b6c8d"}`)
+			fmt.Fprintln(w, `{"progressDetail":{},"aux":{"Tag":"latest","Digest":"sha256:abcdef","Size":1234}}`)
+		case "/images/localhost:5000/hello/json":
+			fmt.Fprint(w, `{"Id":"sha256:123456","RepoTags":["localhost:5000/hello:latest"]}`)
+		case "/images/hello/tag":
+			if r.URL.Query().Get("repo") != "localhost:5000/hello" || r.URL.Query().Get("tag") != "latest" {
+				t.Errorf("should get %s but got %s", "localhost:5000/hello:latest", r.URL.RawQuery)
+			}
+			w.WriteHeader(http.StatusCreated)
+		case "/images/hello":
+			if r.Method != "DELETE" {
+				t.Errorf("should get %s but got %s", "DELETE", r.Method)
+			}
+			fmt.Fprint(w, `[{"Untagged":"hello:latest"}]`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"message":"No such image"}`)
+		}
+	}))
+}
+
+func TestImage(t *testing.T) {
+	server := fakeImageEngine(t)
+	defer server.Close()
+	api := &API{endpoint: server.URL}
+	ctx := context.Background()
+
+	t.Run("tag", func(t *testing.T) {
+		if err := api.TagImage(ctx, "hello", "localhost:5000/hello"); err != nil {
+			t.Fatal(err)
+		}
+		if err := api.TagImage(ctx, "nobody", "localhost:5000/nobody:latest"); !failure.IsNotFound(err) {
+			t.Fatalf("should get not found error but got %v", err)
+		}
+	})
+
+	t.Run("remove", func(t *testing.T) {
+		if err := api.RemoveImage(ctx, "hello"); err != nil {
+			t.Fatal(err)
+		}
+		if err := api.RemoveImage(ctx, "nobody"); !failure.IsNotFound(err) {
+			t.Fatalf("should get not found error but got %v", err)
+		}
+	})
+
+	t.Run("inspect", func(t *testing.T) {
+		var image dockerTypes.ImageInspect
+		if err := api.InspectImage(ctx, "localhost:5000/hello", &image); err != nil {
+			t.Fatal(err)
+		}
+		if image.ID != "sha256:123456" {
+			t.Fatalf("should get %s but got %s", "sha256:123456", image.ID)
+		}
+		if err := api.InspectImage(ctx, "nobody", &image); !failure.IsNotFound(err) {
+			t.Fatalf("should get not found error but got %v", err)
+		}
+	})
+
+	t.Run("push", func(t *testing.T) {
+		auth, _ := json.Marshal(dockerTypes.AuthConfig{Username: "user", Password: "pass"})
+		digest, err := api.PushImage(ctx, "localhost:5000/hello:latest", base64.URLEncoding.EncodeToString(auth))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if digest != "sha256:abcdef" {
+			t.Fatalf("should get %s but got %s", "sha256:abcdef", digest)
+		}
+		if _, err := api.PushImage(ctx, "localhost:5000/hello", ""); err == nil {
+			t.Fatalf("should get error without credentials")
+		}
+	})
+}
@@ -95,7 +95,7 @@ func (api *API) Run(port int, service *types.Service) error {
 	if err != nil {
 		return errors.Wrap(err, "error new container create request")
 	}
-	client := &http.Client{Timeout: 20 * time.Second}
+	client := api.httpClient(20 * time.Second)
 	resp, err := client.Do(request)
 	if err != nil {
 		return errors.Wrap(err, "error do start container request")
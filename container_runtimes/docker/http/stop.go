@@ -14,7 +14,7 @@ func (api *API) Stop(name string) error {
 	if err != nil {
 		return err
 	}
-	client := &http.Client{Timeout: 20 * time.Second}
+	client := api.httpClient(20 * time.Second)
 	_, err = client.Do(request)
 	if err != nil {
 		return err
@@ -22,3 +22,24 @@ func (api *API) Stop(name string) error {
 
 	return nil
 }
+
+// Remove a container by name
+func (api *API) Remove(name string) error {
+	path := fmt.Sprintf("/containers/%s?force=true", name)
+	url := fmt.Sprintf("%s%s", api.endpoint, path)
+	request, err := http.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return err
+	}
+	client := api.httpClient(20 * time.Second)
+	resp, err := client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent {
+		return fmt.Errorf("request %s failed: %d - %s", url, resp.StatusCode, resp.Status)
+	}
+	return nil
+}
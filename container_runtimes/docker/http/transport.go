@@ -0,0 +1,72 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/docker/go-connections/tlsconfig"
+)
+
+// DefaultEndpoint endpoint of local docker engine
+const DefaultEndpoint = "unix:///var/run/docker.sock"
+
+// DialFunc dial a connection to docker engine, e.g. through a SSH tunnel
+type DialFunc func(ctx context.Context, network string, addr string) (net.Conn, error)
+
+// TLSOptions client certificates to verify and authenticate with a docker engine listening on TCP,
+// it's the same as '--tlscacert', '--tlscert' and '--tlskey' of docker cli
+type TLSOptions struct {
+	CACert string
+	Cert   string
+	Key    string
+}
+
+// Dial create a API talks to docker engine on endpoint, it's 'unix:///path/to/docker.sock'
+// or 'tcp://<host>:<port>', TLS is required for a TCP endpoint
+func Dial(endpoint string, tlsOptions *TLSOptions) (*API, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("invalid docker endpoint %s: %v", endpoint, err)
+	}
+
+	switch u.Scheme {
+	case "unix":
+		socket := u.Path
+		return CreateWithDialer(func(ctx context.Context, _ string, _ string) (net.Conn, error) {
+			var d net.Dialer
+			return d.DialContext(ctx, "unix", socket)
+		})
+	case "tcp":
+		if tlsOptions == nil {
+			return nil, fmt.Errorf("TLS certificates required to connect docker engine on %s, docker engine exposed on TCP without TLS gives root access of host to anyone could reach it", endpoint)
+		}
+		config, err := tlsconfig.Client(tlsconfig.Options{
+			CAFile:   tlsOptions.CACert,
+			CertFile: tlsOptions.Cert,
+			KeyFile:  tlsOptions.Key,
+		})
+		if err != nil {
+			return nil, err
+		}
+		transport := &http.Transport{
+			TLSClientConfig:     config,
+			TLSHandshakeTimeout: 10 * time.Second,
+		}
+		return connect("https://"+u.Host, transport)
+	default:
+		return nil, fmt.Errorf("unsupported docker endpoint %s, it should be 'unix://' or 'tcp://'", endpoint)
+	}
+}
+
+// CreateWithDialer create a API talks to docker engine over the connections made by dial
+func CreateWithDialer(dial DialFunc) (*API, error) {
+	transport := &http.Transport{
+		DialContext: dial,
+	}
+	// host of endpoint is ignored since connections are made by dial
+	return connect("http://docker", transport)
+}
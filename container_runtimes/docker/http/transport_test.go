@@ -0,0 +1,95 @@
+package api
+
+import (
+	"context"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	dockerTypes "github.com/docker/docker/api/types"
+)
+
+// fakeEngine a docker engine answers version and container inspection
+func fakeEngine(w http.ResponseWriter, r *http.Request) {
+	switch r.URL.Path {
+	case "/version", "/v1.40/version":
+		fmt.Fprint(w, `{"Version":"19.03.5","ApiVersion":"1.40"}`)
+	case "/v1.40/containers/hello/json":
+		fmt.Fprint(w, `{"Id":"1","Name":"/hello"}`)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func inspect(t *testing.T, api *API) {
+	if api.version != "1.40" {
+		t.Fatalf("should get %s but got %s", "1.40", api.version)
+	}
+	var container dockerTypes.ContainerJSON
+	if err := api.InspectContainer(context.Background(), "hello", &container); err != nil {
+		t.Fatal(err)
+	}
+	if container.Name != "/hello" {
+		t.Fatalf("should get %s but got %s", "/hello", container.Name)
+	}
+}
+
+func TestDial(t *testing.T) {
+	dir, err := ioutil.TempDir("", "fx-docker")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	t.Run("unix", func(t *testing.T) {
+		socket := filepath.Join(dir, "docker.sock")
+		listener, err := net.Listen("unix", socket)
+		if err != nil {
+			t.Fatal(err)
+		}
+		server := httptest.NewUnstartedServer(http.HandlerFunc(fakeEngine))
+		server.Listener = listener
+		server.Start()
+		defer server.Close()
+
+		api, err := Dial("unix://"+socket, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		inspect(t, api)
+	})
+
+	t.Run("tcp", func(t *testing.T) {
+		server := httptest.NewTLSServer(http.HandlerFunc(fakeEngine))
+		defer server.Close()
+		endpoint := strings.Replace(server.URL, "https://", "tcp://", 1)
+
+		if _, err := Dial(endpoint, nil); err == nil {
+			t.Fatalf("should get error when dial a tcp endpoint without TLS")
+		}
+
+		caCert := filepath.Join(dir, "ca.pem")
+		body := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+		if err := ioutil.WriteFile(caCert, body, 0600); err != nil {
+			t.Fatal(err)
+		}
+		api, err := Dial(endpoint, &TLSOptions{CACert: caCert})
+		if err != nil {
+			t.Fatal(err)
+		}
+		inspect(t, api)
+	})
+
+	t.Run("unsupported", func(t *testing.T) {
+		if _, err := Dial("http://127.0.0.1:8866", nil); err == nil {
+			t.Fatalf("should get error with unsupported endpoint")
+		}
+	})
+}
@@ -0,0 +1,82 @@
+package options
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/docker/docker/api/types/container"
+	"github.com/metrue/fx/constants"
+	"github.com/metrue/fx/types"
+)
+
+const (
+	// HealthInterval interval between health checks
+	HealthInterval = 10 * time.Second
+	// HealthTimeout timeout of a health check
+	HealthTimeout = 3 * time.Second
+	// HealthStartPeriod time for service to get ready, failed checks in it are not counted
+	HealthStartPeriod = 5 * time.Second
+	// HealthRetries failed checks in a row to mark service unhealthy
+	HealthRetries = 3
+)
+
+// Apply resources, restart policy and health check in options to config and hostConfig of container
+func Apply(config *container.Config, hostConfig *container.HostConfig, options types.DeployOptions) error {
+	hostConfig.Memory = options.Memory
+	hostConfig.NanoCPUs = int64(options.CPUs * 1e9)
+
+	if options.Restart != "" {
+		policy, err := types.ParseRestartPolicy(options.Restart)
+		if err != nil {
+			return err
+		}
+		hostConfig.RestartPolicy = container.RestartPolicy{
+			Name:              policy.Name,
+			MaximumRetryCount: policy.MaximumRetryCount,
+		}
+	}
+
+	if options.HealthPath != "" {
+		config.Healthcheck = Healthcheck(options.HealthPath)
+	}
+	return nil
+}
+
+// Of options of a container started with Apply, environment variables are not included
+func Of(config *container.Config, hostConfig *container.HostConfig) types.DeployOptions {
+	options := types.DeployOptions{}
+	if hostConfig != nil {
+		options.Memory = hostConfig.Memory
+		options.CPUs = float64(hostConfig.NanoCPUs) / 1e9
+		if policy := hostConfig.RestartPolicy; policy.Name != "" {
+			options.Restart = policy.Name
+			if policy.MaximumRetryCount > 0 {
+				options.Restart = fmt.Sprintf("%s:%d", policy.Name, policy.MaximumRetryCount)
+			}
+		}
+	}
+	if config != nil && config.Healthcheck != nil && len(config.Healthcheck.Test) == 2 {
+		prefix := fmt.Sprintf("wget -q -O /dev/null 'http://localhost:%d", constants.FxContainerExposePort)
+		if test := config.Healthcheck.Test[1]; strings.HasPrefix(test, prefix) {
+			options.HealthPath = strings.SplitN(strings.TrimPrefix(test, prefix), "'", 2)[0]
+		}
+	}
+	return options
+}
+
+// Healthcheck check health of service by requesting path on its expose port in container,
+// wget and curl are tried since images of different languages have different tools
+func Healthcheck(path string) *container.HealthConfig {
+	url := fmt.Sprintf("http://localhost:%d%s", constants.FxContainerExposePort, path)
+	return &container.HealthConfig{
+		Test: []string{
+			"CMD-SHELL",
+			fmt.Sprintf("wget -q -O /dev/null '%s' || curl -fsS -o /dev/null '%s' || exit 1", url, url),
+		},
+		Interval:    HealthInterval,
+		Timeout:     HealthTimeout,
+		StartPeriod: HealthStartPeriod,
+		Retries:     HealthRetries,
+	}
+}
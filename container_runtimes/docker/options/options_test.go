@@ -0,0 +1,60 @@
+package options
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/docker/docker/api/types/container"
+	"github.com/metrue/fx/types"
+)
+
+func TestApply(t *testing.T) {
+	t.Run("empty", func(t *testing.T) {
+		config := &container.Config{}
+		hostConfig := &container.HostConfig{}
+		if err := Apply(config, hostConfig, types.DeployOptions{}); err != nil {
+			t.Fatal(err)
+		}
+		if hostConfig.Memory != 0 || hostConfig.NanoCPUs != 0 || hostConfig.RestartPolicy.Name != "" || config.Healthcheck != nil {
+			t.Fatalf("should not set anything but got %+v and %+v", config, hostConfig)
+		}
+	})
+
+	t.Run("options", func(t *testing.T) {
+		config := &container.Config{}
+		hostConfig := &container.HostConfig{}
+		options := types.DeployOptions{
+			Memory:     256 * 1024 * 1024,
+			CPUs:       1.5,
+			Restart:    "on-failure:5",
+			HealthPath: "/health",
+		}
+		if err := Apply(config, hostConfig, options); err != nil {
+			t.Fatal(err)
+		}
+		if hostConfig.Memory != options.Memory {
+			t.Fatalf("should get %d but got %d", options.Memory, hostConfig.Memory)
+		}
+		if hostConfig.NanoCPUs != 1500000000 {
+			t.Fatalf("should get %d but got %d", 1500000000, hostConfig.NanoCPUs)
+		}
+		if hostConfig.RestartPolicy.Name != "on-failure" || hostConfig.RestartPolicy.MaximumRetryCount != 5 {
+			t.Fatalf("should get %s but got %+v", options.Restart, hostConfig.RestartPolicy)
+		}
+		if config.Healthcheck == nil || !strings.Contains(config.Healthcheck.Test[1], "http://localhost:3000/health") {
+			t.Fatalf("should check health on /health but got %+v", config.Healthcheck)
+		}
+
+		if restored := Of(config, hostConfig); !reflect.DeepEqual(restored, options) {
+			t.Fatalf("should get %+v but got %+v", options, restored)
+		}
+	})
+
+	t.Run("invalid restart policy", func(t *testing.T) {
+		err := Apply(&container.Config{}, &container.HostConfig{}, types.DeployOptions{Restart: "always:3"})
+		if err == nil {
+			t.Fatalf("should get error with invalid restart policy")
+		}
+	})
+}
@@ -0,0 +1,119 @@
+package progress
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/apex/log"
+	"github.com/metrue/fx/pkg/spinner"
+)
+
+// Message a message of JSON message stream returned by Docker when building or pushing image
+type Message struct {
+	Stream       string          `json:"stream,omitempty"`
+	Status       string          `json:"status,omitempty"`
+	ID           string          `json:"id,omitempty"`
+	Progress     string          `json:"progress,omitempty"`
+	Error        *ErrorDetail    `json:"errorDetail,omitempty"`
+	ErrorMessage string          `json:"error,omitempty"`
+	Aux          json.RawMessage `json:"aux,omitempty"`
+}
+
+// ErrorDetail error reported in message stream
+type ErrorDetail struct {
+	Code    int    `json:"code,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+// Event a progress event decoded from message stream
+type Event struct {
+	// Step the step of Dockerfile in progress, e.g. "Step 3/7 : RUN npm install"
+	Step    string
+	Message Message
+}
+
+// Decode read message stream to its end, fn is called with every event,
+// error reported in stream is returned with the step it failed at
+func Decode(r io.Reader, fn func(e Event)) error {
+	decoder := json.NewDecoder(r)
+	var step string
+	for {
+		var msg Message
+		if err := decoder.Decode(&msg); err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+
+		if strings.HasPrefix(msg.Stream, "Step ") {
+			step = strings.TrimSpace(msg.Stream)
+		}
+		if fn != nil {
+			fn(Event{Step: step, Message: msg})
+		}
+
+		if msg.Error != nil || msg.ErrorMessage != "" {
+			detail := msg.ErrorMessage
+			if msg.Error != nil && msg.Error.Message != "" {
+				detail = msg.Error.Message
+			}
+			if step != "" {
+				return fmt.Errorf("%s failed: %s", step, detail)
+			}
+			return fmt.Errorf("%s", detail)
+		}
+	}
+}
+
+// Report show the step in progress on spinner of task, and write the full log as debug log,
+// which is printed with --verbose
+func Report(task string) func(e Event) {
+	var step string
+	return func(e Event) {
+		if e.Step != step {
+			step = e.Step
+			spinner.Update(task + ": " + step)
+		}
+		msg := e.Message
+		if msg.Stream != "" {
+			log.Debug(strings.TrimRight(msg.Stream, "\n"))
+		} else if msg.Status != "" {
+			log.Debugf("%s %s %s", msg.ID, msg.Status, msg.Progress)
+		}
+	}
+}
+
+// DigestOf the digest of pushed image carried by aux message at the end of push stream, empty if there is not
+func DigestOf(msg Message) string {
+	if len(msg.Aux) == 0 {
+		return ""
+	}
+	var aux struct {
+		Digest string `json:"Digest"`
+	}
+	if err := json.Unmarshal(msg.Aux, &aux); err != nil {
+		return ""
+	}
+	return aux.Digest
+}
+
+// Pushed read message stream of pushing image to its end with progress reported, digest of pushed image is returned
+func Pushed(r io.Reader) (string, error) {
+	report := Report("pushing")
+	var digest string
+	if err := Decode(r, func(e Event) {
+		report(e)
+		if d := DigestOf(e.Message); d != "" {
+			digest = d
+		}
+	}); err != nil {
+		return "", err
+	}
+	if digest == "" {
+		return "", fmt.Errorf("no digest of pushed image reported")
+	}
+	return digest, nil
+}
@@ -0,0 +1,82 @@
+package progress
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDecode(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		stream := `{"stream":"Step 1/2 : FROM metrue/fx-node-base\n"}
+{"stream":" ---> 2a4b6c8d\n"}
+{"stream":"Step 2/2 : COPY . .\n"}
+{"aux":{"ID":"sha256:abcdef"}}
+{"stream":"Successfully built abcdef\n"}
+`
+		steps := []string{}
+		if err := Decode(strings.NewReader(stream), func(e Event) {
+			if len(steps) == 0 || steps[len(steps)-1] != e.Step {
+				steps = append(steps, e.Step)
+			}
+		}); err != nil {
+			t.Fatal(err)
+		}
+		if len(steps) != 2 || steps[1] != "Step 2/2 : COPY . ." {
+			t.Fatalf("should get steps in order but got %v", steps)
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		stream := `{"stream":"Step 1/3 : FROM metrue/fx-node-base\n"}
+{"stream":"Step 2/3 : RUN npm install\n"}
+{"stream":"npm ERR! 404 Not Found\n"}
+{"errorDetail":{"code":1,"message":"The command '/bin/sh -c npm install' returned a non-zero code: 1"},"error":"The command '/bin/sh -c npm install' returned a non-zero code: 1"}
+`
+		err := Decode(strings.NewReader(stream), nil)
+		if err == nil {
+			t.Fatalf("should get error when build failed")
+		}
+		expected := "Step 2/3 : RUN npm install failed: The command '/bin/sh -c npm install' returned a non-zero code: 1"
+		if err.Error() != expected {
+			t.Fatalf("should get %s but got %s", expected, err)
+		}
+	})
+
+	t.Run("malformed", func(t *testing.T) {
+		if err := Decode(strings.NewReader(`{"stream":`), nil); err == nil {
+			t.Fatalf("should get error with malformed stream")
+		}
+	})
+}
+
+func TestPushed(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		stream := `{"status":"The push refers to repository [localhost:5000/hello]"}
+{"status":"Pushed","progressDetail":{},"id":"2a4b6c8d"}
+{"status":"latest: digest: sha256:abcdef size: 1234"}
+{"progressDetail":{},"aux":{"Tag":"latest","Digest":"sha256:abcdef","Size":1234}}
+`
+		digest, err := Pushed(strings.NewReader(stream))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if digest != "sha256:abcdef" {
+			t.Fatalf("should get %s but got %s", "sha256:abcdef", digest)
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		stream := `{"status":"The push refers to repository [localhost:5000/hello]"}
+{"errorDetail":{"message":"unauthorized: authentication required"},"error":"unauthorized: authentication required"}
+`
+		if _, err := Pushed(strings.NewReader(stream)); err == nil || err.Error() != "unauthorized: authentication required" {
+			t.Fatalf("should get error of push but got %v", err)
+		}
+	})
+
+	t.Run("no digest", func(t *testing.T) {
+		if _, err := Pushed(strings.NewReader(`{"status":"Pushed","id":"2a4b6c8d"}`)); err == nil {
+			t.Fatalf("should get error when there is no digest")
+		}
+	})
+}
@@ -6,23 +6,32 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/apex/log"
 	dockerTypes "github.com/docker/docker/api/types"
 	dockerTypesContainer "github.com/docker/docker/api/types/container"
 	dockerFilters "github.com/docker/docker/api/types/filters"
+	dockerTypesNetwork "github.com/docker/docker/api/types/network"
 	"github.com/docker/docker/client"
+	"github.com/docker/docker/pkg/stdcopy"
 	"github.com/docker/go-connections/nat"
 	"github.com/google/uuid"
 	containerruntimes "github.com/metrue/fx/container_runtimes"
+	dockerOptions "github.com/metrue/fx/container_runtimes/docker/options"
+	"github.com/metrue/fx/container_runtimes/docker/progress"
 	"github.com/metrue/fx/types"
 	"github.com/metrue/fx/utils"
 )
 
+// fxNetworkName network functions are attached to, the same one the HTTP API client uses
+const fxNetworkName = "fx-net"
+
 // Docker docker as image builder
 type Docker struct {
 	*client.Client
@@ -39,7 +48,7 @@ func CreateClient(ctx context.Context) (*Docker, error) {
 }
 
 // BuildImage a directory to be a image
-func (d *Docker) BuildImage(ctx context.Context, workdir string, name string) error {
+func (d *Docker) BuildImage(ctx context.Context, workdir string, name string, buildArgs map[string]string) error {
 	tarDir, err := ioutil.TempDir("/tmp", "fx-tar")
 	if err != nil {
 		return err
@@ -65,6 +74,11 @@ func (d *Docker) BuildImage(ctx context.Context, workdir string, name string) er
 		Labels: map[string]string{
 			"belong-to": "fx",
 		},
+		BuildArgs: map[string]*string{},
+	}
+	for k, v := range buildArgs {
+		value := v
+		options.BuildArgs[k] = &value
 	}
 
 	resp, err := d.ImageBuild(ctx, dockerBuildContext, options)
@@ -72,57 +86,24 @@ func (d *Docker) BuildImage(ctx context.Context, workdir string, name string) er
 		return err
 	}
 	defer resp.Body.Close()
-	body, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return err
-	}
-	if os.Getenv("DEBUG") != "" {
-		log.Info(string(body))
-	}
-
-	return nil
+	// build failure is reported in message stream
+	return progress.Decode(resp.Body, progress.Report("building"))
 }
 
-// PushImage push image to hub.docker.com
-func (d *Docker) PushImage(ctx context.Context, name string) (string, error) {
-	username := os.Getenv("DOCKER_USERNAME")
-	password := os.Getenv("DOCKER_PASSWORD")
-	if username == "" || password == "" {
-		return "", fmt.Errorf("DOCKER_USERNAME and DOCKER_PASSWORD required for push image to registry")
-	}
-
-	// TODO support private registry, like Azure Container registry
-	authConfig := dockerTypes.AuthConfig{
-		Username: username,
-		Password: password,
-	}
-	encodedJSON, err := json.Marshal(authConfig)
-	if err != nil {
-		return "", err
-	}
-
-	nameWithTag := username + "/" + name
-	if err := d.ImageTag(ctx, name, nameWithTag); err != nil {
-		return "", err
-	}
-
-	options := dockerTypes.ImagePushOptions{
-		RegistryAuth: base64.URLEncoding.EncodeToString(encodedJSON),
+// PushImage push image with name of registry in it, e.g. 'registry.example.com/team/hello:latest',
+// registryAuth is credentials of registry encoded as X-Registry-Auth header, digest of pushed image is returned
+func (d *Docker) PushImage(ctx context.Context, name string, registryAuth string) (string, error) {
+	// an empty RegistryAuth makes docker client ask for credentials by PrivilegeFunc
+	if registryAuth == "" {
+		registryAuth = base64.URLEncoding.EncodeToString([]byte("{}"))
 	}
-	resp, err := d.ImagePush(ctx, nameWithTag, options)
+	resp, err := d.ImagePush(ctx, name, dockerTypes.ImagePushOptions{RegistryAuth: registryAuth})
 	if err != nil {
 		return "", err
 	}
 	defer resp.Close()
-
-	if os.Getenv("DEBUG") != "" {
-		body, err := ioutil.ReadAll(resp)
-		if err != nil {
-			return "", err
-		}
-		log.Info(string(body))
-	}
-	return nameWithTag, nil
+	// push failure is reported in message stream
+	return progress.Pushed(resp)
 }
 
 // InspectImage inspect a image
@@ -140,8 +121,14 @@ func (d *Docker) TagImage(ctx context.Context, name string, tag string) error {
 	return d.ImageTag(ctx, name, tag)
 }
 
+// RemoveImage remove image
+func (d *Docker) RemoveImage(ctx context.Context, name string) error {
+	_, err := d.ImageRemove(ctx, name, dockerTypes.ImageRemoveOptions{Force: true, PruneChildren: true})
+	return err
+}
+
 // StartContainer create and start a container from given image
-func (d *Docker) StartContainer(ctx context.Context, name string, image string, ports []types.PortBinding) error {
+func (d *Docker) StartContainer(ctx context.Context, name string, image string, ports []types.PortBinding, options types.DeployOptions) error {
 	portSet := nat.PortSet{}
 	portMap := nat.PortMap{}
 	for _, binding := range ports {
@@ -153,18 +140,37 @@ func (d *Docker) StartContainer(ctx context.Context, name string, image string,
 		}
 		port := nat.Port(fmt.Sprintf("%d/tcp", binding.ContainerExposePort))
 		portSet[port] = struct{}{}
-		portMap[port] = bindings
+		// several host ports could be bound to the same container port
+		portMap[port] = append(portMap[port], bindings...)
 	}
 	config := &dockerTypesContainer.Config{
 		Image:        image,
 		ExposedPorts: portSet,
+		Env:          options.EnvList(),
 	}
 
+	// container is not auto removed so that its logs are still available after it crashes,
+	// StopContainer removes it
 	hostConfig := &dockerTypesContainer.HostConfig{
-		AutoRemove:   true,
 		PortBindings: portMap,
 	}
-	resp, err := d.ContainerCreate(ctx, config, hostConfig, nil, name)
+	if err := dockerOptions.Apply(config, hostConfig, options); err != nil {
+		return err
+	}
+	networkID, err := d.fxNetwork(ctx)
+	if err != nil {
+		return err
+	}
+	// container is reachable by its name from other containers on fx-net, e.g. fx gateway
+	networkConfig := &dockerTypesNetwork.NetworkingConfig{
+		EndpointsConfig: map[string]*dockerTypesNetwork.EndpointSettings{
+			fxNetworkName: &dockerTypesNetwork.EndpointSettings{
+				NetworkID: networkID,
+				Aliases:   []string{name},
+			},
+		},
+	}
+	resp, err := d.ContainerCreate(ctx, config, hostConfig, networkConfig, name)
 	if os.Getenv("DEBUG") != "" {
 		body, err := json.Marshal(resp)
 		if err != nil {
@@ -182,9 +188,38 @@ func (d *Docker) StartContainer(ctx context.Context, name string, image string,
 	return nil
 }
 
+// fxNetwork id of fx-net, it's created when not existed
+func (d *Docker) fxNetwork(ctx context.Context) (string, error) {
+	networks, err := d.NetworkList(ctx, dockerTypes.NetworkListOptions{
+		Filters: dockerFilters.NewArgs(dockerFilters.Arg("name", fxNetworkName)),
+	})
+	if err != nil {
+		return "", err
+	}
+	// name filter matches partially
+	for _, n := range networks {
+		if n.Name == fxNetworkName {
+			return n.ID, nil
+		}
+	}
+	created, err := d.NetworkCreate(ctx, fxNetworkName, dockerTypes.NetworkCreate{CheckDuplicate: true})
+	if err != nil {
+		return "", err
+	}
+	return created.ID, nil
+}
+
+// CopyToContainer extract a tar archive into the directory at path of a container
+func (d *Docker) CopyToContainer(ctx context.Context, name string, path string, content io.Reader) error {
+	return d.Client.CopyToContainer(ctx, name, path, content, dockerTypes.CopyToContainerOptions{})
+}
+
 // StopContainer stop and remove container
 func (d *Docker) StopContainer(ctx context.Context, name string) error {
-	return d.ContainerStop(ctx, name, nil)
+	if err := d.ContainerStop(ctx, name, nil); err != nil {
+		return err
+	}
+	return d.ContainerRemove(ctx, name, dockerTypes.ContainerRemoveOptions{Force: true})
 }
 
 // InspectContainer inspect a container
@@ -219,12 +254,13 @@ func (d *Docker) ListContainer(ctx context.Context, name string) ([]types.Servic
 		// https://github.com/moby/moby/issues/6705
 		if strings.HasPrefix(container.Names[0], fmt.Sprintf("/%s", name)) {
 			svs[container.Image] = types.Service{
-				Name:  container.Names[0],
-				Image: container.Image,
-				ID:    container.ID,
-				Host:  container.Ports[0].IP,
-				Port:  int(container.Ports[0].PublicPort),
-				State: container.State,
+				Name:    container.Names[0],
+				Image:   container.Image,
+				ID:      container.ID,
+				Host:    container.Ports[0].IP,
+				Port:    int(container.Ports[0].PublicPort),
+				State:   container.State,
+				Created: time.Unix(container.Created, 0),
 			}
 		}
 	}
@@ -235,6 +271,25 @@ func (d *Docker) ListContainer(ctx context.Context, name string) ([]types.Servic
 	return services, nil
 }
 
+// StreamContainerLogs write stdout and stderr of a container into w
+func (d *Docker) StreamContainerLogs(ctx context.Context, name string, options types.LogOptions, w io.Writer) error {
+	resp, err := d.ContainerLogs(ctx, name, dockerTypes.ContainerLogsOptions{
+		ShowStdout: true,
+		ShowStderr: true,
+		Follow:     options.Follow,
+		Since:      options.Since,
+		Tail:       options.Tail,
+	})
+	if err != nil {
+		return err
+	}
+	defer resp.Close()
+
+	// fx containers are created without TTY, so stdout and stderr are multiplexed in one stream
+	_, err = stdcopy.StdCopy(w, w, resp)
+	return err
+}
+
 // Version get version of docker engine
 func (d *Docker) Version(ctx context.Context) (string, error) {
 	ping, err := d.Ping(ctx)
@@ -20,7 +20,7 @@ func TestDocker(t *testing.T) {
 
 	workdir := "../fixture"
 	name := "fx-test-docker-image"
-	if err := cli.BuildImage(ctx, workdir, name); err != nil {
+	if err := cli.BuildImage(ctx, workdir, name, nil); err != nil {
 		t.Fatal(err)
 	}
 
@@ -48,7 +48,7 @@ func TestDocker(t *testing.T) {
 			ServiceBindingPort:  9000,
 			ContainerExposePort: 3000,
 		},
-	}); err != nil {
+	}, types.DeployOptions{}); err != nil {
 		t.Fatal(err)
 	}
 
@@ -60,18 +60,21 @@ func TestDocker(t *testing.T) {
 		t.Fatalf("should get %s but got %s", "/"+name, container.Name)
 	}
 
-	username := os.Getenv("DOCKER_USERNAME")
-	password := os.Getenv("DOCKER_PASSWORD")
-	if username == "" || password == "" {
-		t.Skip("Skip push image test since DOCKER_USERNAME and DOCKER_PASSWORD not set in environment variable")
+	// a registry accepts anonymous push, e.g. 'docker run -d -p 5000:5000 registry:2'
+	registry := os.Getenv("FX_TEST_REGISTRY")
+	if registry == "" {
+		t.Skip("Skip push image test since FX_TEST_REGISTRY not set in environment variable")
 	}
 
-	img, err := cli.PushImage(ctx, name)
+	nameWithRegistry := registry + "/" + name + ":latest"
+	if err := cli.TagImage(ctx, name, nameWithRegistry); err != nil {
+		t.Fatal(err)
+	}
+	digest, err := cli.PushImage(ctx, nameWithRegistry, "")
 	if err != nil {
 		t.Fatal(err)
 	}
-	expect := username + "/" + name
-	if img != expect {
-		t.Fatalf("should get %s but got %s", expect, img)
+	if !strings.HasPrefix(digest, "sha256:") {
+		t.Fatalf("should get digest of image but got %s", digest)
 	}
 }
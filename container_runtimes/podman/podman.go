@@ -0,0 +1,492 @@
+package podman
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/apex/log"
+	"github.com/docker/docker/api/types/container"
+	timetypes "github.com/docker/docker/api/types/time"
+	"github.com/docker/docker/pkg/stdcopy"
+	containerruntimes "github.com/metrue/fx/container_runtimes"
+	dockerOptions "github.com/metrue/fx/container_runtimes/docker/options"
+	"github.com/metrue/fx/container_runtimes/docker/progress"
+	"github.com/metrue/fx/packer"
+	"github.com/metrue/fx/types"
+)
+
+// endpoint base URL of libpod API, host is ignored since requests go to the unix socket
+const endpoint = "http://podman/libpod"
+
+// Podman interact with Podman REST API over its unix socket
+type Podman struct {
+	socket string
+	client *http.Client
+}
+
+// DefaultSocket socket of Podman service, it's in $XDG_RUNTIME_DIR for rootless Podman
+func DefaultSocket() string {
+	if dir := os.Getenv("XDG_RUNTIME_DIR"); dir != "" && os.Geteuid() != 0 {
+		return filepath.Join(dir, "podman", "podman.sock")
+	}
+	return "/run/podman/podman.sock"
+}
+
+// Create a Podman client talks to socket, DefaultSocket is used when socket is empty
+func Create(socket string) (*Podman, error) {
+	if socket == "" {
+		socket = DefaultSocket()
+	}
+	p := &Podman{
+		socket: socket,
+		client: &http.Client{
+			Transport: &http.Transport{
+				DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+					var d net.Dialer
+					return d.DialContext(ctx, "unix", socket)
+				},
+			},
+		},
+	}
+	if _, err := p.Version(context.Background()); err != nil {
+		return nil, fmt.Errorf("could not connect to Podman on %s: %v", socket, err)
+	}
+	return p, nil
+}
+
+// Socket unix socket of Podman service
+func (p *Podman) Socket() string {
+	return p.socket
+}
+
+// do send a request to libpod API, a response with status other than expectStatus is an error,
+// response body is decoded into v when it's not nil
+func (p *Podman) do(ctx context.Context, method string, path string, body io.Reader, expectStatus []int, v interface{}) error {
+	url := endpoint + path
+	req, err := http.NewRequest(method, url, body)
+	if err != nil {
+		return err
+	}
+	req = req.WithContext(ctx)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	expected := false
+	for _, status := range expectStatus {
+		expected = expected || resp.StatusCode == status
+	}
+	if !expected {
+		return fmt.Errorf("request %s %s failed: %d - %s", method, path, resp.StatusCode, errorMessage(b))
+	}
+	if v == nil || len(b) == 0 {
+		return nil
+	}
+	return json.Unmarshal(b, v)
+}
+
+// errorMessage message of error response of libpod API
+func errorMessage(body []byte) string {
+	var e struct {
+		Message string `json:"message"`
+	}
+	if err := json.Unmarshal(body, &e); err == nil && e.Message != "" {
+		return e.Message
+	}
+	return strings.TrimSpace(string(body))
+}
+
+// Version get version of Podman API
+func (p *Podman) Version(ctx context.Context) (string, error) {
+	var v struct {
+		Version    string `json:"Version"`
+		APIVersion string `json:"ApiVersion"`
+	}
+	if err := p.do(ctx, "GET", "/version", nil, []int{http.StatusOK}, &v); err != nil {
+		return "", err
+	}
+	return v.APIVersion, nil
+}
+
+// BuildImage build image
+func (p *Podman) BuildImage(ctx context.Context, workdir string, name string, buildArgs map[string]string) error {
+	buildContext, err := packer.PackIntoTar(workdir)
+	if err != nil {
+		return err
+	}
+
+	labels, err := json.Marshal(map[string]string{"belong-to": "fx"})
+	if err != nil {
+		return err
+	}
+	query := url.Values{}
+	query.Set("t", name)
+	query.Set("dockerfile", "Dockerfile")
+	query.Set("labels", string(labels))
+	if len(buildArgs) > 0 {
+		args, err := json.Marshal(buildArgs)
+		if err != nil {
+			return err
+		}
+		query.Set("buildargs", string(args))
+	}
+
+	req, err := http.NewRequest("POST", endpoint+"/build?"+query.Encode(), bytes.NewReader(buildContext))
+	if err != nil {
+		return err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", "application/x-tar")
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := ioutil.ReadAll(resp.Body)
+		return fmt.Errorf("request POST /build failed: %d - %s", resp.StatusCode, errorMessage(body))
+	}
+	// Podman reports build progress in the same message stream as Docker
+	return progress.Decode(resp.Body, progress.Report("building"))
+}
+
+// PushImage push image with name of registry in it, registryAuth is credentials of registry encoded as
+// X-Registry-Auth header, digest of pushed image is returned
+func (p *Podman) PushImage(ctx context.Context, name string, registryAuth string) (string, error) {
+	query := url.Values{}
+	query.Set("destination", name)
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/images/%s/push?%s", endpoint, name, query.Encode()), nil)
+	if err != nil {
+		return "", err
+	}
+	req = req.WithContext(ctx)
+	if registryAuth != "" {
+		req.Header.Set("X-Registry-Auth", registryAuth)
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := ioutil.ReadAll(resp.Body)
+		return "", fmt.Errorf("request POST /images/%s/push failed: %d - %s", name, resp.StatusCode, errorMessage(body))
+	}
+	return pushed(resp.Body)
+}
+
+// pushed read report stream of pushing image to its end, push failure is reported in it with 200 status code
+func pushed(r io.Reader) (string, error) {
+	var digest string
+	decoder := json.NewDecoder(r)
+	for {
+		var report struct {
+			Stream         string `json:"stream"`
+			Error          string `json:"error"`
+			ManifestDigest string `json:"manifestdigest"`
+		}
+		if err := decoder.Decode(&report); err == io.EOF {
+			break
+		} else if err != nil {
+			return "", err
+		}
+		if report.Error != "" {
+			return "", fmt.Errorf("push failed: %s", report.Error)
+		}
+		if report.Stream != "" {
+			log.Debug(strings.TrimRight(report.Stream, "\n"))
+		}
+		if report.ManifestDigest != "" {
+			digest = report.ManifestDigest
+		}
+	}
+	if digest == "" {
+		return "", fmt.Errorf("no digest of pushed image reported, please upgrade Podman")
+	}
+	return digest, nil
+}
+
+// InspectImage inspect image
+func (p *Podman) InspectImage(ctx context.Context, name string, img interface{}) error {
+	return p.do(ctx, "GET", fmt.Sprintf("/images/%s/json", name), nil, []int{http.StatusOK}, img)
+}
+
+// TagImage tag image
+func (p *Podman) TagImage(ctx context.Context, name string, tag string) error {
+	repo, t := containerruntimes.SplitTag(tag)
+	query := url.Values{}
+	query.Set("repo", repo)
+	query.Set("tag", t)
+	path := fmt.Sprintf("/images/%s/tag?%s", name, query.Encode())
+	return p.do(ctx, "POST", path, nil, []int{http.StatusCreated, http.StatusOK}, nil)
+}
+
+// RemoveImage remove image
+func (p *Podman) RemoveImage(ctx context.Context, name string) error {
+	return p.do(ctx, "DELETE", fmt.Sprintf("/images/%s?force=true", name), nil, []int{http.StatusOK}, nil)
+}
+
+// portMapping port mapping of container spec
+type portMapping struct {
+	HostIP        string `json:"host_ip,omitempty"`
+	HostPort      uint16 `json:"host_port"`
+	ContainerPort uint16 `json:"container_port"`
+	Protocol      string `json:"protocol,omitempty"`
+}
+
+// resourceLimits resource limits of container spec, in the format of OCI runtime spec
+type resourceLimits struct {
+	Memory *memoryLimit `json:"memory,omitempty"`
+	CPU    *cpuLimit    `json:"cpu,omitempty"`
+}
+
+type memoryLimit struct {
+	Limit int64 `json:"limit"`
+}
+
+type cpuLimit struct {
+	Quota  int64  `json:"quota"`
+	Period uint64 `json:"period"`
+}
+
+// spec container spec to create a container by libpod API
+type spec struct {
+	Name           string                  `json:"name"`
+	Image          string                  `json:"image"`
+	Env            map[string]string       `json:"env,omitempty"`
+	Labels         map[string]string       `json:"labels,omitempty"`
+	PortMappings   []portMapping           `json:"portmappings,omitempty"`
+	ResourceLimits *resourceLimits         `json:"resource_limits,omitempty"`
+	RestartPolicy  string                  `json:"restart_policy,omitempty"`
+	RestartTries   *uint                   `json:"restart_tries,omitempty"`
+	HealthConfig   *container.HealthConfig `json:"healthconfig,omitempty"`
+}
+
+// cpuPeriod CFS period to limit CPUs of container by quota
+const cpuPeriod = 100000
+
+// withOptions apply resources, restart policy and health check in options to spec
+func (s *spec) withOptions(options types.DeployOptions) error {
+	if options.Memory > 0 || options.CPUs > 0 {
+		s.ResourceLimits = &resourceLimits{}
+	}
+	if options.Memory > 0 {
+		s.ResourceLimits.Memory = &memoryLimit{Limit: options.Memory}
+	}
+	if options.CPUs > 0 {
+		s.ResourceLimits.CPU = &cpuLimit{Quota: int64(options.CPUs * cpuPeriod), Period: cpuPeriod}
+	}
+	if options.Restart != "" {
+		policy, err := types.ParseRestartPolicy(options.Restart)
+		if err != nil {
+			return err
+		}
+		s.RestartPolicy = policy.Name
+		if policy.MaximumRetryCount > 0 {
+			tries := uint(policy.MaximumRetryCount)
+			s.RestartTries = &tries
+		}
+	}
+	if options.HealthPath != "" {
+		s.HealthConfig = dockerOptions.Healthcheck(options.HealthPath)
+	}
+	return nil
+}
+
+// StartContainer start container
+func (p *Podman) StartContainer(ctx context.Context, name string, image string, bindings []types.PortBinding, options types.DeployOptions) error {
+	s := spec{
+		Name:   name,
+		Image:  image,
+		Env:    map[string]string{},
+		Labels: map[string]string{"belong-to": "fx"},
+	}
+	for _, env := range options.EnvList() {
+		kv := strings.SplitN(env, "=", 2)
+		s.Env[kv[0]] = kv[1]
+	}
+	for _, binding := range bindings {
+		s.PortMappings = append(s.PortMappings, portMapping{
+			HostIP:        types.DefaultHost,
+			HostPort:      uint16(binding.ServiceBindingPort),
+			ContainerPort: uint16(binding.ContainerExposePort),
+			Protocol:      "tcp",
+		})
+	}
+	if err := s.withOptions(options); err != nil {
+		return err
+	}
+	body, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+
+	var created struct {
+		ID string `json:"Id"`
+	}
+	if err := p.do(ctx, "POST", "/containers/create", bytes.NewReader(body), []int{http.StatusCreated}, &created); err != nil {
+		return fmt.Errorf("create container failed: %v", err)
+	}
+	if created.ID == "" {
+		return fmt.Errorf("container id is missing")
+	}
+
+	path := fmt.Sprintf("/containers/%s/start", created.ID)
+	if err := p.do(ctx, "POST", path, nil, []int{http.StatusNoContent, http.StatusNotModified}, nil); err != nil {
+		return fmt.Errorf("start container failed: %v", err)
+	}
+	return nil
+}
+
+// StopContainer stop and remove a container
+func (p *Podman) StopContainer(ctx context.Context, name string) error {
+	path := fmt.Sprintf("/containers/%s/stop", name)
+	if err := p.do(ctx, "POST", path, nil, []int{http.StatusNoContent, http.StatusNotModified}, nil); err != nil {
+		return err
+	}
+	path = fmt.Sprintf("/containers/%s?force=true", name)
+	return p.do(ctx, "DELETE", path, nil, []int{http.StatusOK, http.StatusNoContent}, nil)
+}
+
+// InspectContainer inspect container, libpod reports container in the same fields as Docker does,
+// so it could be decoded into a Docker ContainerJSON
+func (p *Podman) InspectContainer(ctx context.Context, name string, container interface{}) error {
+	return p.do(ctx, "GET", fmt.Sprintf("/containers/%s/json", name), nil, []int{http.StatusOK}, container)
+}
+
+// listedContainer container in the list of libpod API
+type listedContainer struct {
+	ID      string        `json:"Id"`
+	Names   []string      `json:"Names"`
+	Image   string        `json:"Image"`
+	State   string        `json:"State"`
+	Ports   []portMapping `json:"Ports"`
+	Created int64         `json:"Created"`
+}
+
+// ListContainer list containers created by fx, whose name starts with name
+func (p *Podman) ListContainer(ctx context.Context, name string) ([]types.Service, error) {
+	filters, err := json.Marshal(map[string][]string{"label": []string{"belong-to=fx"}})
+	if err != nil {
+		return nil, err
+	}
+	query := url.Values{}
+	query.Set("all", "true")
+	query.Set("filters", string(filters))
+
+	var containers []listedContainer
+	if err := p.do(ctx, "GET", "/containers/json?"+query.Encode(), nil, []int{http.StatusOK}, &containers); err != nil {
+		return nil, err
+	}
+
+	services := []types.Service{}
+	for _, c := range containers {
+		if len(c.Names) == 0 || !strings.HasPrefix(c.Names[0], name) {
+			continue
+		}
+		service := types.Service{
+			ID:    c.ID,
+			Name:  c.Names[0],
+			Image: c.Image,
+			State: c.State,
+		}
+		if c.Created > 0 {
+			service.Created = time.Unix(c.Created, 0)
+		}
+		if len(c.Ports) > 0 {
+			service.Host = c.Ports[0].HostIP
+			service.Port = int(c.Ports[0].HostPort)
+		}
+		services = append(services, service)
+	}
+	return services, nil
+}
+
+// CopyToContainer extract a tar archive into the directory at path of a container
+func (p *Podman) CopyToContainer(ctx context.Context, name string, path string, content io.Reader) error {
+	query := url.Values{}
+	query.Set("path", path)
+	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/containers/%s/archive?%s", endpoint, name, query.Encode()), content)
+	if err != nil {
+		return err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", "application/x-tar")
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		body, _ := ioutil.ReadAll(resp.Body)
+		return fmt.Errorf("request PUT /containers/%s/archive failed: %d - %s", name, resp.StatusCode, errorMessage(body))
+	}
+	return nil
+}
+
+// StreamContainerLogs write stdout and stderr of a container into w,
+// it blocks until container exits when options.Follow is set
+func (p *Podman) StreamContainerLogs(ctx context.Context, name string, options types.LogOptions, w io.Writer) error {
+	query := url.Values{}
+	query.Set("stdout", "true")
+	query.Set("stderr", "true")
+	if options.Follow {
+		query.Set("follow", "true")
+	}
+	if options.Since != "" {
+		ts, err := timetypes.GetTimestamp(options.Since, time.Now())
+		if err != nil {
+			return err
+		}
+		query.Set("since", ts)
+	}
+	if options.Tail != "" {
+		query.Set("tail", options.Tail)
+	}
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/containers/%s/logs?%s", endpoint, name, query.Encode()), nil)
+	if err != nil {
+		return err
+	}
+	req = req.WithContext(ctx)
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		body, _ := ioutil.ReadAll(resp.Body)
+		return fmt.Errorf("request GET /containers/%s/logs failed: %d - %s", name, resp.StatusCode, errorMessage(body))
+	}
+
+	// fx containers are created without TTY, so stdout and stderr are multiplexed in one stream
+	_, err = stdcopy.StdCopy(w, w, resp.Body)
+	return err
+}
+
+var (
+	_ containerruntimes.ContainerRuntime = &Podman{}
+)
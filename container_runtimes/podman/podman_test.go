@@ -0,0 +1,257 @@
+package podman
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	dockerTypes "github.com/docker/docker/api/types"
+	"github.com/metrue/fx/types"
+)
+
+const sha = "4c8c2a0f9b1b2b6d8e6f1a3d5c7e9b0a2c4e6f8a1b3d5f7e9c0a2b4d6f8e1a3c"
+
+// fakePodman a fake libpod API keeps containers in memory
+type fakePodman struct {
+	mux        sync.Mutex
+	images     map[string]bool
+	containers map[string]spec
+	running    map[string]bool
+}
+
+func (f *fakePodman) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mux.Lock()
+	defer f.mux.Unlock()
+
+	path := strings.TrimPrefix(r.URL.Path, "/libpod")
+	parts := strings.Split(strings.Trim(path, "/"), "/")
+	switch {
+	case r.Method == "GET" && path == "/version":
+		fmt.Fprint(w, `{"Version":"3.0.1","ApiVersion":"1.40"}`)
+	case r.Method == "POST" && path == "/build":
+		if r.Header.Get("Content-Type") != "application/x-tar" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if r.URL.Query().Get("buildargs") != "" {
+			fmt.Fprint(w, `{"stream":"STEP 1: FROM scratch\n"}`+"\n"+`{"error":"build args are not allowed"}`+"\n")
+			return
+		}
+		f.images[r.URL.Query().Get("t")] = true
+		fmt.Fprint(w, `{"stream":"STEP 1: FROM scratch\n"}`+"\n")
+	case r.Method == "POST" && len(parts) == 3 && parts[0] == "images" && parts[2] == "tag":
+		if !f.images[parts[1]] {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"message":"no such image"}`)
+			return
+		}
+		f.images[r.URL.Query().Get("repo")+":"+r.URL.Query().Get("tag")] = true
+		w.WriteHeader(http.StatusCreated)
+	case r.Method == "POST" && strings.HasPrefix(path, "/images/") && strings.HasSuffix(path, "/push"):
+		name := strings.TrimSuffix(strings.TrimPrefix(path, "/images/"), "/push")
+		if !f.images[name] || r.URL.Query().Get("destination") != name {
+			fmt.Fprint(w, `{"error":"no such image"}`+"\n")
+			return
+		}
+		if r.Header.Get("X-Registry-Auth") == "" {
+			fmt.Fprint(w, `{"error":"unauthorized"}`+"\n")
+			return
+		}
+		fmt.Fprint(w, `{"stream":"Copying blob sha256:1\n"}`+"\n"+`{"manifestdigest":"sha256:`+sha+`"}`+"\n")
+	case r.Method == "POST" && path == "/containers/create":
+		var s spec
+		if err := json.NewDecoder(r.Body).Decode(&s); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if !f.images[s.Image] {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprintf(w, `{"message":"image %s not found"}`, s.Image)
+			return
+		}
+		f.containers[s.Name] = s
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, `{"Id":"%s"}`, s.Name)
+	case r.Method == "POST" && len(parts) == 3 && parts[0] == "containers" && parts[2] == "start":
+		f.running[parts[1]] = true
+		w.WriteHeader(http.StatusNoContent)
+	case r.Method == "POST" && len(parts) == 3 && parts[0] == "containers" && parts[2] == "stop":
+		if _, ok := f.containers[parts[1]]; !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		f.running[parts[1]] = false
+		w.WriteHeader(http.StatusNoContent)
+	case r.Method == "DELETE" && len(parts) == 2 && parts[0] == "containers":
+		delete(f.containers, parts[1])
+		w.WriteHeader(http.StatusOK)
+	case r.Method == "GET" && len(parts) == 3 && parts[0] == "containers" && parts[2] == "json":
+		s, ok := f.containers[parts[1]]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"message":"no such container"}`)
+			return
+		}
+		status := "exited"
+		if f.running[s.Name] {
+			status = "running"
+		}
+		fmt.Fprintf(w, `{"Id":"%s","Name":"%s","Image":"%s","State":{"Status":"%s","Running":%v},"NetworkSettings":{"Ports":{"%d/tcp":[{"HostIp":"%s","HostPort":"%d"}]}}}`,
+			s.Name, s.Name, s.Image, status, f.running[s.Name],
+			s.PortMappings[0].ContainerPort, s.PortMappings[0].HostIP, s.PortMappings[0].HostPort)
+	case r.Method == "GET" && path == "/containers/json":
+		if r.URL.Query().Get("filters") != `{"label":["belong-to=fx"]}` {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		containers := []listedContainer{}
+		for _, s := range f.containers {
+			containers = append(containers, listedContainer{
+				ID:    s.Name,
+				Names: []string{s.Name},
+				Image: s.Image,
+				State: "running",
+				Ports: s.PortMappings,
+			})
+		}
+		_ = json.NewEncoder(w).Encode(containers)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+// serve fake Podman on a unix socket
+func serve(t *testing.T) (*fakePodman, string, func()) {
+	dir, err := ioutil.TempDir("", "fx-podman")
+	if err != nil {
+		t.Fatal(err)
+	}
+	socket := filepath.Join(dir, "podman.sock")
+	listener, err := net.Listen("unix", socket)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fake := &fakePodman{
+		images:     map[string]bool{},
+		containers: map[string]spec{},
+		running:    map[string]bool{},
+	}
+	server := httptest.NewUnstartedServer(fake)
+	server.Listener = listener
+	server.Start()
+	return fake, socket, func() {
+		server.Close()
+		os.RemoveAll(dir)
+	}
+}
+
+func TestPodman(t *testing.T) {
+	fake, socket, stop := serve(t)
+	defer stop()
+
+	ctx := context.Background()
+	p, err := Create(socket)
+	if err != nil {
+		t.Fatal(err)
+	}
+	version, err := p.Version(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if version != "1.40" {
+		t.Fatalf("should get %s but got %s", "1.40", version)
+	}
+
+	name := "fx-podman-test"
+	if err := p.BuildImage(ctx, "../docker/fixture", name, nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := p.BuildImage(ctx, "../docker/fixture", name, map[string]string{"A": "1"}); err == nil {
+		t.Fatalf("should get error when build failed")
+	}
+	if err := p.TagImage(ctx, name, name+":latest"); err != nil {
+		t.Fatal(err)
+	}
+	if err := p.TagImage(ctx, "not-existed", "not-existed:latest"); err == nil || !strings.Contains(err.Error(), "no such image") {
+		t.Fatalf("should get error message from Podman but got %v", err)
+	}
+	if err := p.TagImage(ctx, name, "localhost:5000/hello"); err != nil {
+		t.Fatal(err)
+	}
+	if !fake.images["localhost:5000/hello:latest"] {
+		t.Fatalf("should tag with registry port kept in repository")
+	}
+	digest, err := p.PushImage(ctx, "localhost:5000/hello:latest", "e30=")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if digest != "sha256:"+sha {
+		t.Fatalf("should get %s but got %s", "sha256:"+sha, digest)
+	}
+	if _, err := p.PushImage(ctx, "localhost:5000/hello:latest", ""); err == nil || !strings.Contains(err.Error(), "unauthorized") {
+		t.Fatalf("should get error of push but got %v", err)
+	}
+
+	bindings := []types.PortBinding{
+		types.PortBinding{ServiceBindingPort: 20001, ContainerExposePort: 3000},
+	}
+	options := types.DeployOptions{
+		Env:        map[string]string{"GREETING": "hello"},
+		Memory:     512 * 1024 * 1024,
+		CPUs:       0.5,
+		Restart:    "on-failure:3",
+		HealthPath: "/health",
+	}
+	if err := p.StartContainer(ctx, name, name+":latest", bindings, options); err != nil {
+		t.Fatal(err)
+	}
+
+	created := fake.containers[name]
+	if created.Env["GREETING"] != "hello" {
+		t.Fatalf("should get env %s but got %v", "GREETING=hello", created.Env)
+	}
+	if created.ResourceLimits.Memory.Limit != options.Memory || created.ResourceLimits.CPU.Quota != 50000 {
+		t.Fatalf("should get resource limits of options but got %+v", created.ResourceLimits)
+	}
+	if created.RestartPolicy != "on-failure" || *created.RestartTries != 3 {
+		t.Fatalf("should get restart policy %s but got %s:%d", options.Restart, created.RestartPolicy, *created.RestartTries)
+	}
+	if created.HealthConfig == nil || !strings.Contains(created.HealthConfig.Test[1], "http://localhost:3000/health") {
+		t.Fatalf("should check health on /health but got %+v", created.HealthConfig)
+	}
+
+	var container dockerTypes.ContainerJSON
+	if err := p.InspectContainer(ctx, name, &container); err != nil {
+		t.Fatal(err)
+	}
+	if !container.State.Running {
+		t.Fatalf("container should be running")
+	}
+	if container.NetworkSettings.Ports["3000/tcp"][0].HostPort != "20001" {
+		t.Fatalf("should get port %s but got %v", "20001", container.NetworkSettings.Ports)
+	}
+
+	services, err := p.ListContainer(ctx, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(services) != 1 || services[0].Name != name || services[0].Port != 20001 {
+		t.Fatalf("should get container %s on %d but got %v", name, 20001, services)
+	}
+
+	if err := p.StopContainer(ctx, name); err != nil {
+		t.Fatal(err)
+	}
+	if err := p.InspectContainer(ctx, name, &container); err == nil {
+		t.Fatalf("container should be removed")
+	}
+}
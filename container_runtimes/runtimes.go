@@ -2,19 +2,34 @@ package containerruntimes
 
 import (
 	"context"
+	"io"
+	"strings"
 
 	"github.com/metrue/fx/types"
 )
 
 // ContainerRuntime interface
 type ContainerRuntime interface {
-	BuildImage(ctx context.Context, workdir string, name string) error
-	PushImage(ctx context.Context, name string) (string, error)
+	BuildImage(ctx context.Context, workdir string, name string, buildArgs map[string]string) error
+	PushImage(ctx context.Context, name string, registryAuth string) (string, error)
 	InspectImage(ctx context.Context, name string, img interface{}) error
 	TagImage(ctx context.Context, name string, tag string) error
-	StartContainer(ctx context.Context, name string, image string, bindings []types.PortBinding) error
+	RemoveImage(ctx context.Context, name string) error
+	StartContainer(ctx context.Context, name string, image string, bindings []types.PortBinding, options types.DeployOptions) error
 	StopContainer(ctx context.Context, name string) error
 	InspectContainer(ctx context.Context, name string, container interface{}) error
 	ListContainer(ctx context.Context, filter string) ([]types.Service, error)
+	CopyToContainer(ctx context.Context, name string, path string, content io.Reader) error
+	StreamContainerLogs(ctx context.Context, name string, options types.LogOptions, w io.Writer) error
 	Version(ctx context.Context) (string, error)
 }
+
+// SplitTag split reference into repository and tag, 'latest' is the tag when there is none,
+// a colon before the last slash is the port of registry, e.g. 'localhost:5000/hello'
+func SplitTag(ref string) (repo string, tag string) {
+	i := strings.LastIndex(ref, ":")
+	if i < 0 || i < strings.LastIndex(ref, "/") {
+		return ref, "latest"
+	}
+	return ref[:i], ref[i+1:]
+}
@@ -0,0 +1,18 @@
+package containerruntimes
+
+import "testing"
+
+func TestSplitTag(t *testing.T) {
+	cases := map[string][2]string{
+		"hello":                      {"hello", "latest"},
+		"hello:v1":                   {"hello", "v1"},
+		"localhost:5000/hello":       {"localhost:5000/hello", "latest"},
+		"localhost:5000/team/a:v1.2": {"localhost:5000/team/a", "v1.2"},
+	}
+	for ref, expect := range cases {
+		repo, tag := SplitTag(ref)
+		if repo != expect[0] || tag != expect[1] {
+			t.Fatalf("should get %v but got %s %s", expect, repo, tag)
+		}
+	}
+}
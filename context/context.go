@@ -39,6 +39,11 @@ func FromCliContext(c *cli.Context) *Context {
 	return ctx
 }
 
+// Fork create a context inheriting all values of parent, values set to it are not seen by parent
+func Fork(parent Contexter) *Context {
+	return &Context{parent.GetContext()}
+}
+
 // WithCliContext set cli.Context
 func (ctx *Context) WithCliContext(c *cli.Context) {
 	newCtx := context.WithValue(ctx.Context, keyCliCtx, c)
@@ -27,3 +27,17 @@ func TestContext(t *testing.T) {
 		t.Fatalf("should get context")
 	}
 }
+
+func TestFork(t *testing.T) {
+	parent := NewContext()
+	parent.Set("k_1", "v_1")
+
+	child := Fork(parent)
+	child.Set("k_2", "v_2")
+	if child.Get("k_1") != "v_1" {
+		t.Fatalf("should get %v but got %v", "v_1", child.Get("k_1"))
+	}
+	if parent.Get("k_2") != nil {
+		t.Fatalf("should not get %v from parent", parent.Get("k_2"))
+	}
+}
@@ -58,7 +58,7 @@ docker_packer <encrypt_docker_project_source_tree> <image_name>
 		log.Fatalf("could not create a docker client: %v", err)
 		os.Exit(1)
 	}
-	if err := dockerClient.BuildImage(ctx, workdir, name); err != nil {
+	if err := dockerClient.BuildImage(ctx, workdir, name, nil); err != nil {
 		log.Fatalf("could not build image: %s", err)
 		os.Exit(1)
 	}
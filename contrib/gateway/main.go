@@ -0,0 +1,33 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/metrue/fx/constants"
+	"github.com/metrue/fx/pkg/gateway"
+)
+
+func main() {
+	port := gateway.Port
+	// it's the port proxy of a function when upstream is given, it listens on the port of function
+	// so that it's reached like the function on fx-net
+	if upstream := os.Getenv(gateway.UpstreamEnv); upstream != "" {
+		if err := gateway.SeedRoutes(gateway.RoutesPath(), upstream); err != nil {
+			log.Fatalf("could not seed route to %s: %v", upstream, err)
+		}
+		port = constants.FxContainerExposePort
+	}
+
+	g := gateway.New()
+	go g.Watch(gateway.RoutesPath(), time.Second, make(chan struct{}))
+
+	addr := fmt.Sprintf(":%d", port)
+	log.Printf("fx gateway listening on %s", addr)
+	if err := http.ListenAndServe(addr, g); err != nil {
+		log.Fatalf("fx gateway stopped: %v", err)
+	}
+}
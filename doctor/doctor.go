@@ -1,6 +1,8 @@
 package doctor
 
 import (
+	"fmt"
+
 	"github.com/apex/log"
 	"github.com/metrue/fx/constants"
 	"github.com/metrue/fx/pkg/command"
@@ -9,7 +11,8 @@ import (
 
 // Doctor health checking
 type Doctor struct {
-	host string
+	host  string
+	agent bool
 
 	sshClient ssh.Client
 }
@@ -32,30 +35,33 @@ func New(host, user, password string) *Doctor {
 	}
 }
 
+// WithAgent check the legacy fx agent also
+func (d *Doctor) WithAgent() *Doctor {
+	d.agent = true
+	return d
+}
+
 // Start diagnosis
 func (d *Doctor) Start() error {
 	checkDocker := "docker version"
 	checkAgent := "docker inspect " + constants.AgentContainerName
 
-	cmds := []*command.Command{}
+	var runner command.Runner = command.NewLocalRunner()
 	if !isLocal(d.host) {
-		cmds = append(cmds,
-			command.New("check if dockerd is running", checkDocker, command.NewRemoteRunner(d.sshClient)),
-			command.New("check if fx agent is running", checkAgent, command.NewRemoteRunner(d.sshClient)),
-		)
-	} else {
-		cmds = append(cmds,
-			command.New("check if dockerd is running", checkDocker, command.NewLocalRunner()),
-			command.New("check if fx agent is running", checkAgent, command.NewLocalRunner()),
-		)
+		runner = command.NewRemoteRunner(d.sshClient)
+	}
+	cmds := []*command.Command{
+		command.New("check if dockerd is running", checkDocker, runner),
+	}
+	if d.agent {
+		cmds = append(cmds, command.New("check if fx agent is running", checkAgent, runner))
 	}
 
 	for _, cmd := range cmds {
 		if _, err := cmd.Exec(); err != nil {
-			log.Fatalf("Doctor check:%s: %s", cmd.Name, err)
-		} else {
-			log.Infof("Doctor check:%s: \u2713", cmd.Name)
+			return fmt.Errorf("doctor check %s failed: %s", cmd.Name, err)
 		}
+		log.Infof("Doctor check:%s: \u2713", cmd.Name)
 	}
 
 	return nil
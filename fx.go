@@ -8,16 +8,40 @@ import (
 	"regexp"
 
 	"github.com/apex/log"
-	"github.com/google/uuid"
-	aurora "github.com/logrusorgru/aurora"
 	"github.com/metrue/fx/context"
 	"github.com/metrue/fx/handlers"
 	"github.com/metrue/fx/middlewares"
+	"github.com/metrue/fx/pkg/failure"
+	"github.com/metrue/fx/pkg/healthcheck"
 	"github.com/urfave/cli"
 )
 
 const version = "0.8.73"
 
+// namespaceFlag namespace of Kubernetes infrastructure to work in
+var namespaceFlag = cli.StringFlag{
+	Name:  "namespace",
+	Usage: "Kubernetes namespace, the default namespace of infrastructure is used when it's not given",
+}
+
+// outputFlag output format of services, infrastructures and errors
+var outputFlag = cli.StringFlag{
+	Name:  "output, o",
+	Usage: "output format, 'table', 'wide', 'json' or 'yaml', errors are printed as JSON objects with 'json'",
+}
+
+// formatFlag Go template to render services and infrastructures
+var formatFlag = cli.StringFlag{
+	Name:  "format",
+	Usage: "render every service, infrastructure, result or resource with a Go template, e.g. '{{.Name}} {{.Port}}'",
+}
+
+// errors are reported by main with exit code of their kinds, as JSON objects when output is json
+var (
+	debug  bool
+	output string
+)
+
 func init() {
 	go checkForUpdate()
 }
@@ -27,13 +51,37 @@ func handle(fns ...func(ctx context.Contexter) error) func(ctx *cli.Context) err
 		ctx := context.FromCliContext(c)
 		for _, fn := range fns {
 			if err := fn(ctx); err != nil {
-				panic(err)
+				if c.IsSet("output") {
+					output = c.String("output")
+				}
+				return err
 			}
 		}
 		return nil
 	}
 }
 
+// onUsageError show help of command and report error of unknown or invalid flags as a usage error
+func onUsageError(c *cli.Context, err error, isSubcommand bool) error {
+	fmt.Fprintf(c.App.Writer, "Incorrect Usage: %s\n\n", err)
+	if c.Command.Name != "" {
+		// nolint: errcheck
+		cli.ShowCommandHelp(c, c.Command.Name)
+	} else {
+		// nolint: errcheck
+		cli.ShowAppHelp(c)
+	}
+	return failure.Usage(err)
+}
+
+// withUsageError set onUsageError to commands and their subcommands, OnUsageError of app is not used by them
+func withUsageError(commands []cli.Command) {
+	for i := range commands {
+		commands[i].OnUsageError = onUsageError
+		withUsageError(commands[i].Subcommands)
+	}
+}
+
 func checkForUpdate() {
 	const releaseURL = "https://api.github.com/repos/metrue/fx/releases/latest"
 	resp, err := http.Get(releaseURL)
@@ -68,14 +116,26 @@ func main() {
 	app.Name = "fx"
 	app.Usage = "makes function as a service"
 	app.Version = version
-
-	defer func() {
-		if r := recover(); r != nil {
-			fmt.Println(aurora.Red("*****************"))
-			fmt.Println(r)
-			fmt.Println(aurora.Red("*****************"))
+	app.Flags = []cli.Flag{
+		cli.BoolFlag{
+			Name:  "verbose",
+			Usage: "print full logs of tasks, e.g. image building",
+		},
+		cli.BoolFlag{
+			Name:  "debug",
+			Usage: "print stack of error when command failed",
+		},
+		outputFlag,
+		formatFlag,
+	}
+	app.Before = func(c *cli.Context) error {
+		if c.Bool("verbose") {
+			log.SetLevel(log.DebugLevel)
 		}
-	}()
+		debug = c.Bool("debug")
+		output = c.String("output")
+		return nil
+	}
 
 	app.Commands = []cli.Command{
 		{
@@ -88,7 +148,7 @@ func main() {
 					Flags: []cli.Flag{
 						cli.StringFlag{
 							Name:  "type, t",
-							Usage: "infracture type, 'docker', 'k8s' and 'k3s' support",
+							Usage: "infracture type, 'docker', 'k8s', 'k3s' and 'podman' support",
 						},
 						cli.StringFlag{
 							Name:  "name, n",
@@ -98,6 +158,34 @@ func main() {
 							Name:  "host",
 							Usage: "user and ip of your host, eg. 'root@182.12.1.12'",
 						},
+						cli.StringFlag{
+							Name:  "endpoint",
+							Usage: "endpoint of docker engine, 'unix:///var/run/docker.sock' or 'tcp://<ip>:2376' with TLS, host is not provisioned when it's given",
+						},
+						cli.StringFlag{
+							Name:  "tlscacert",
+							Usage: "CA certificate to verify docker engine on a 'tcp://' endpoint",
+						},
+						cli.StringFlag{
+							Name:  "tlscert",
+							Usage: "client certificate to authenticate with docker engine on a 'tcp://' endpoint",
+						},
+						cli.StringFlag{
+							Name:  "tlskey",
+							Usage: "client key to authenticate with docker engine on a 'tcp://' endpoint",
+						},
+						cli.BoolFlag{
+							Name:  "insecure-ignore-host-key",
+							Usage: "do not verify host key when docker engine on host is reached through SSH, it's verified with ~/.ssh/known_hosts by default",
+						},
+						cli.BoolFlag{
+							Name:  "agent",
+							Usage: "[legacy] expose docker engine of host by fx-agent on port 8866 without authentication",
+						},
+						cli.StringFlag{
+							Name:  "socket",
+							Usage: "unix socket of Podman service, default socket of Podman is used when it's not given",
+						},
 						cli.StringFlag{
 							Name:  "master",
 							Usage: "serve as master node in K3S cluster, eg. 'root@182.12.1.12'",
@@ -106,6 +194,18 @@ func main() {
 							Name:  "agents",
 							Usage: "serve as agent node in K3S cluster, eg. 'root@187.1. 2. 3,root@123.3.2.1'",
 						},
+						cli.StringFlag{
+							Name:  "namespace",
+							Usage: "default Kubernetes namespace to deploy functions into",
+						},
+						cli.StringFlag{
+							Name:  "registry",
+							Usage: "registry to push images to and deploy them by digest, images are built in cluster of Kubernetes, e.g. 'registry.example.com/team'",
+						},
+						cli.StringFlag{
+							Name:  "registry-secret",
+							Usage: "docker config json secret in Kubernetes cluster to push and pull images of registry",
+						},
 					},
 
 					Action: handle(
@@ -116,14 +216,72 @@ func main() {
 				{
 					Name:  "list",
 					Usage: "list all infrastructures",
+					Flags: []cli.Flag{
+						outputFlag,
+						formatFlag,
+					},
 					Action: handle(
+						middlewares.Output,
 						middlewares.LoadConfig,
 						handlers.ListInfra,
 					),
 				},
+				{
+					Name:      "show",
+					Usage:     "show details of a infrastructure, the current one when name is not given",
+					ArgsUsage: "[name]",
+					Flags: []cli.Flag{
+						outputFlag,
+						formatFlag,
+					},
+					Action: handle(
+						middlewares.Output,
+						middlewares.LoadConfig,
+						handlers.ShowInfra,
+					),
+				},
+				{
+					Name:      "remove",
+					Aliases:   []string{"rm"},
+					Usage:     "remove infrastructures and the kubeconfig files of them",
+					ArgsUsage: "<name> [name ...]",
+					Flags: []cli.Flag{
+						cli.BoolFlag{
+							Name:  "force, f",
+							Usage: "remove the current infrastructure as well",
+						},
+					},
+					Action: handle(
+						middlewares.LoadConfig,
+						handlers.RemoveInfra,
+					),
+				},
+				{
+					Name:      "rename",
+					Usage:     "rename a infrastructure",
+					ArgsUsage: "<name> <new name>",
+					Action: handle(
+						middlewares.LoadConfig,
+						handlers.RenameInfra,
+					),
+				},
 				{
 					Name:  "use",
 					Usage: "set current context to target cloud with given name",
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:  "namespace",
+							Usage: "set default Kubernetes namespace of the infrastructure as well",
+						},
+						cli.StringFlag{
+							Name:  "registry",
+							Usage: "registry to push images to and deploy them by digest, images are built in cluster of Kubernetes, e.g. 'registry.example.com/team'",
+						},
+						cli.StringFlag{
+							Name:  "registry-secret",
+							Usage: "docker config json secret in Kubernetes cluster to push and pull images of registry",
+						},
+					},
 					Action: handle(
 						middlewares.LoadConfig,
 						handlers.UseInfra,
@@ -131,6 +289,22 @@ func main() {
 				},
 			},
 		},
+		{
+			Name:      "init",
+			Usage:     "create a function with its handler, a dependency manifest and a sample test",
+			ArgsUsage: "<name>",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "lang, l",
+					Usage: "programming language of function, see --list",
+				},
+				cli.BoolFlag{
+					Name:  "list",
+					Usage: "list supported languages",
+				},
+			},
+			Action: handle(handlers.Init),
+		},
 		{
 			Name:      "up",
 			Usage:     "deploy a function",
@@ -138,47 +312,205 @@ func main() {
 			Flags: []cli.Flag{
 				cli.StringFlag{
 					Name:  "name, n",
-					Value: uuid.New().String(),
-					Usage: "service name",
+					Usage: "service name, a random one is generated when it's not given by flag or manifest",
 				},
 				cli.IntFlag{
 					Name:  "port, p",
 					Usage: "port number",
 				},
+				cli.StringFlag{
+					Name:  "manifest, m",
+					Usage: "manifest file, fx.yml next to the sources is used by default",
+				},
+				cli.StringSliceFlag{
+					Name:  "env, e",
+					Usage: "environment variable in KEY=VALUE format, could be given multiple times",
+				},
+				cli.StringFlag{
+					Name:  "env-file",
+					Usage: "file of environment variables, one KEY=VALUE a line",
+				},
+				cli.StringSliceFlag{
+					Name:  "secret",
+					Usage: "name of secret set by 'fx secret set' to be an environment variable, could be given multiple times",
+				},
+				cli.IntFlag{
+					Name:  "parallel",
+					Value: middlewares.DefaultParallel,
+					Usage: "how many functions of a project are built and deployed at the same time",
+				},
+				cli.StringFlag{
+					Name:  "memory",
+					Usage: "memory limit of each service instance, e.g. 512m, 1g",
+				},
+				cli.StringFlag{
+					Name:  "cpus",
+					Usage: "CPUs each service instance could use, e.g. 0.5",
+				},
+				cli.StringFlag{
+					Name:  "restart",
+					Usage: "restart policy on Docker, 'no', 'always', 'on-failure[:max-retries]' or 'unless-stopped'",
+				},
+				cli.StringFlag{
+					Name:  "health-path",
+					Usage: "HTTP path on port 3000 to check health of service, e.g. /health",
+				},
 				cli.BoolFlag{
 					Name:  "healthcheck, hc",
-					Usage: "do a health check after service up",
+					Usage: "wait until service answers HTTP after it's up, exit with non-zero code when it's not",
+				},
+				cli.DurationFlag{
+					Name:  "healthcheck-timeout",
+					Value: healthcheck.DefaultTimeout,
+					Usage: "how long to wait for service to answer HTTP with --healthcheck",
 				},
 				cli.BoolFlag{
 					Name:  "force, f",
 					Usage: "force deploy a function or functions",
 				},
+				namespaceFlag,
+				outputFlag,
+				formatFlag,
 			},
 			Action: handle(
+				middlewares.Output,
 				middlewares.LoadConfig,
-				middlewares.Provision,
 				middlewares.Parse("up"),
-				middlewares.Binding,
-				middlewares.Build,
-				handlers.Up,
+				middlewares.Provision,
+				middlewares.Gateway(middlewares.Project(
+					middlewares.Binding,
+					middlewares.Build,
+					handlers.Up,
+				)),
 			),
 		},
 		{
 			Name:      "down",
 			Usage:     "destroy a service",
 			ArgsUsage: "[service 1, service 2, ....]",
+			Flags: []cli.Flag{
+				cli.BoolFlag{
+					Name:  "all",
+					Usage: "destroy all services, in the namespace on Kubernetes",
+				},
+				cli.BoolFlag{
+					Name:  "dry-run",
+					Usage: "list the resources to be removed without removing them",
+				},
+				cli.BoolFlag{
+					Name:  "include-unlabeled",
+					Usage: "remove resources not labeled as created by fx on Kubernetes as well, e.g. the ones created by an earlier fx, check them with --dry-run first",
+				},
+				namespaceFlag,
+				outputFlag,
+				formatFlag,
+			},
 			Action: handle(
+				middlewares.Output,
 				middlewares.Parse("down"),
 				middlewares.LoadConfig,
 				middlewares.Provision,
-				handlers.Down,
+				middlewares.Gateway(handlers.Down),
 			),
 		},
+		{
+			Name:  "gateway",
+			Usage: "manage the gateway which routes /<function name>/... to functions on one port",
+			Subcommands: []cli.Command{
+				{
+					Name:  "start",
+					Usage: "start gateway on docker infrastructure",
+					Flags: []cli.Flag{
+						cli.IntFlag{
+							Name:  "port, p",
+							Value: 8080,
+							Usage: "port number gateway listens on",
+						},
+					},
+					Action: handle(
+						middlewares.Parse("gateway_start"),
+						middlewares.LoadConfig,
+						middlewares.Provision,
+						handlers.StartGateway,
+					),
+				},
+				{
+					Name:  "stop",
+					Usage: "stop gateway",
+					Action: handle(
+						middlewares.LoadConfig,
+						middlewares.Provision,
+						handlers.StopGateway,
+					),
+				},
+			},
+		},
+		{
+			Name:  "secret",
+			Usage: "manage secrets which are encrypted in ~/.fx and injected into services as environment variables",
+			Subcommands: []cli.Command{
+				{
+					Name:      "set",
+					Usage:     "set a secret, value is read from stdin when it's not given",
+					ArgsUsage: "<name> [value]",
+					Action:    handle(handlers.SetSecret),
+				},
+				{
+					Name:   "list",
+					Usage:  "list names of secrets",
+					Action: handle(handlers.ListSecrets),
+				},
+				{
+					Name:      "rm",
+					Usage:     "remove secrets",
+					ArgsUsage: "<name> [name ...]",
+					Action:    handle(handlers.RemoveSecret),
+				},
+			},
+		},
+		{
+			Name:  "registry",
+			Usage: "manage credentials of registries images are pushed to, they're saved in ~/.fx",
+			Subcommands: []cli.Command{
+				{
+					Name:      "login",
+					Usage:     "log in to a registry, Docker Hub when server is not given",
+					ArgsUsage: "[server]",
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:  "username, u",
+							Usage: "username of registry",
+						},
+						cli.StringFlag{
+							Name:  "password, p",
+							Usage: "password of registry",
+						},
+						cli.BoolFlag{
+							Name:  "password-stdin",
+							Usage: "read password from stdin",
+						},
+					},
+					Action: handle(handlers.RegistryLogin),
+				},
+				{
+					Name:      "logout",
+					Usage:     "log out of a registry, Docker Hub when server is not given",
+					ArgsUsage: "[server]",
+					Action:    handle(handlers.RegistryLogout),
+				},
+			},
+		},
 		{
 			Name:    "list",
 			Aliases: []string{"ls"},
 			Usage:   "list deployed services",
+			Flags: []cli.Flag{
+				namespaceFlag,
+				outputFlag,
+				formatFlag,
+			},
 			Action: handle(
+				middlewares.Output,
 				middlewares.Parse("list"),
 				middlewares.LoadConfig,
 				middlewares.Provision,
@@ -186,15 +518,53 @@ func main() {
 			),
 		},
 		{
-			Name:  "call",
-			Usage: "run a function instantly",
+			Name:      "logs",
+			Usage:     "show logs of a service",
+			ArgsUsage: "<service>",
+			Flags: []cli.Flag{
+				cli.BoolFlag{
+					Name:  "follow, f",
+					Usage: "follow log output",
+				},
+				cli.StringFlag{
+					Name:  "since",
+					Usage: "show logs since timestamp (e.g. 2019-12-01T10:00:00Z) or relative (e.g. 10m)",
+				},
+				cli.StringFlag{
+					Name:  "tail",
+					Value: "all",
+					Usage: "number of lines to show from the end of the logs",
+				},
+				namespaceFlag,
+			},
+			Action: handle(
+				middlewares.Parse("logs"),
+				middlewares.LoadConfig,
+				middlewares.Provision,
+				handlers.Logs,
+			),
+		},
+		{
+			Name:      "call",
+			Usage:     "run a function instantly",
+			ArgsUsage: "[func.go func.js func.py func.rb ...] [key=value ...]",
 			Flags: []cli.Flag{
 				cli.StringFlag{
 					Name:  "host, H",
-					Usage: "fx server host, default is localhost",
+					Usage: "fx server host, default is the host of current infrastructure",
+				},
+				cli.StringFlag{
+					Name:  "service, s",
+					Usage: "call a deployed service with given name instead of source codes",
 				},
+				namespaceFlag,
 			},
-			Action: handle(handlers.Call),
+			Action: handle(
+				middlewares.Parse("call"),
+				middlewares.LoadConfig,
+				middlewares.Provision,
+				handlers.Call,
+			),
 		},
 		{
 			Name:  "image",
@@ -235,13 +605,18 @@ func main() {
 			},
 		},
 		{
-			Name:   "doctor",
-			Usage:  "health check for fx",
-			Action: handle(handlers.Doctor),
+			Name:  "doctor",
+			Usage: "health check for fx",
+			Action: handle(
+				middlewares.LoadConfig,
+				handlers.Doctor,
+			),
 		},
 	}
+	app.OnUsageError = onUsageError
+	withUsageError(app.Commands)
 
 	if err := app.Run(os.Args); err != nil {
-		os.Exit(1)
+		os.Exit(failure.Report(os.Stderr, err, output, debug))
 	}
 }
@@ -1,11 +1,169 @@
 package handlers
 
 import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/metrue/fx/config"
+	"github.com/metrue/fx/constants"
+	containerruntimes "github.com/metrue/fx/container_runtimes"
 	"github.com/metrue/fx/context"
+	"github.com/metrue/fx/infra"
+	"github.com/metrue/fx/middlewares"
+	"github.com/metrue/fx/pkg/spinner"
+	"github.com/metrue/fx/types"
+	"github.com/metrue/fx/utils"
+	"github.com/phayes/freeport"
 )
 
 // Call command handle
-func Call(ctx context.Contexter) error {
-	// TODO not supported
+func Call(ctx context.Contexter) (err error) {
+	params := ctx.Get("params").(map[string]string)
+	service := ctx.Get("service").(string)
+
+	var body []byte
+	if service != "" {
+		body, err = callService(ctx, service, params)
+	} else {
+		body, err = callSources(ctx, params)
+	}
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(body))
 	return nil
 }
+
+// callService invokes a service which is already deployed
+func callService(ctx context.Contexter, name string, params map[string]string) (body []byte, err error) {
+	task := "calling " + name
+	spinner.Start(task)
+	defer func() {
+		spinner.Stop(task, err)
+	}()
+
+	deployer := ctx.Get("deployer").(infra.Deployer)
+	service, err := deployer.GetStatus(ctx.GetContext(), name)
+	if err != nil {
+		return nil, err
+	}
+	url := fmt.Sprintf("http://%s:%d", callHost(ctx, service.Host), service.Port)
+	return invoke(url, params)
+}
+
+// callSources packs the sources into a throwaway container, invokes it and removes it
+func callSources(ctx context.Contexter, params map[string]string) (body []byte, err error) {
+	const task = "calling"
+	spinner.Start(task)
+	defer func() {
+		spinner.Stop(task, err)
+	}()
+
+	docker, ok := ctx.Get("docker").(containerruntimes.ContainerRuntime)
+	if !ok {
+		return nil, fmt.Errorf("calling a function from sources requires a docker infrastructure")
+	}
+
+	workdir, err := ioutil.TempDir("", "fx-call")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(workdir)
+
+	if err := middlewares.Pack(ctx, workdir); err != nil {
+		return nil, err
+	}
+
+	name := "fx-call-" + uuid.New().String()
+	if err := docker.BuildImage(ctx.GetContext(), workdir, name, nil); err != nil {
+		return nil, err
+	}
+	// deferred before stopping the container, so it runs after the container is removed
+	defer func() {
+		if removeErr := docker.RemoveImage(ctx.GetContext(), name); removeErr != nil && err == nil {
+			err = removeErr
+		}
+	}()
+
+	port, err := freeport.GetFreePort()
+	if err != nil {
+		return nil, err
+	}
+	bindings := []types.PortBinding{
+		types.PortBinding{
+			ServiceBindingPort:  int32(port),
+			ContainerExposePort: constants.FxContainerExposePort,
+		},
+	}
+	if err := docker.StartContainer(ctx.GetContext(), name, name, bindings, types.DeployOptions{}); err != nil {
+		return nil, err
+	}
+	// StopContainer removes the container as well
+	defer func() {
+		if stopErr := docker.StopContainer(ctx.GetContext(), name); stopErr != nil && err == nil {
+			err = stopErr
+		}
+	}()
+
+	url := fmt.Sprintf("http://%s:%d", callHost(ctx, types.DefaultHost), port)
+	// function may take a while to start up, retry until it answers
+	if err := utils.RunWithRetry(func() error {
+		body, err = invoke(url, params)
+		return err
+	}, time.Second, 10); err != nil {
+		return nil, err
+	}
+	return body, nil
+}
+
+// callHost decides which host to send request to, --host takes precedence,
+// then the host of service, then the host of current docker infrastructure
+func callHost(ctx context.Contexter, host string) string {
+	if h, ok := ctx.Get("host").(string); ok && h != "" {
+		return h
+	}
+	if host != "" && host != types.DefaultHost {
+		return host
+	}
+	if fxConfig, ok := ctx.Get("config").(*config.Config); ok {
+		cloud := fxConfig.Clouds[fxConfig.CurrentCloud]
+		if cloud["type"] == config.CloudTypeDocker && cloud["host"] != "" {
+			return cloud["host"]
+		}
+	}
+	return "127.0.0.1"
+}
+
+func invoke(url string, params map[string]string) ([]byte, error) {
+	body, err := json.Marshal(params)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest("POST", url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	client := &http.Client{Timeout: 20 * time.Second}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode >= 400 {
+		return nil, fmt.Errorf("call %s failed: %d - %s", url, resp.StatusCode, string(b))
+	}
+	return b, nil
+}
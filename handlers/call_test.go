@@ -0,0 +1,70 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	mockCtx "github.com/metrue/fx/context/mocks"
+	mockDeployer "github.com/metrue/fx/infra/mocks"
+	"github.com/metrue/fx/types"
+)
+
+func TestCall(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var params map[string]string
+		if err := json.NewDecoder(r.Body).Decode(&params); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		_, _ = w.Write([]byte(params["a"] + params["b"]))
+	}))
+	defer server.Close()
+
+	host, p, err := net.SplitHostPort(server.Listener.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	port, err := strconv.Atoi(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("service", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ctx := mockCtx.NewMockContexter(ctrl)
+		deployer := mockDeployer.NewMockDeployer(ctrl)
+
+		name := "sample-name"
+		ctx.EXPECT().Get("params").Return(map[string]string{"a": "1", "b": "2"})
+		ctx.EXPECT().Get("service").Return(name)
+		ctx.EXPECT().Get("deployer").Return(deployer)
+		ctx.EXPECT().Get("host").Return("")
+		ctx.EXPECT().GetContext().Return(context.Background())
+		deployer.EXPECT().GetStatus(gomock.Any(), name).Return(types.Service{
+			Name: name,
+			Host: host,
+			Port: port,
+		}, nil)
+		if err := Call(ctx); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	t.Run("invoke", func(t *testing.T) {
+		body, err := invoke(server.URL, map[string]string{"a": "1", "b": "2"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(body) != "12" {
+			t.Fatalf("should get %s but got %s", "12", string(body))
+		}
+	})
+}
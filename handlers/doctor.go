@@ -1,12 +1,15 @@
 package handlers
 
 import (
+	"fmt"
 	"os"
 
 	"github.com/apex/log"
+	"github.com/metrue/fx/config"
 	"github.com/metrue/fx/constants"
 	"github.com/metrue/fx/context"
 	"github.com/metrue/fx/doctor"
+	"github.com/metrue/fx/pkg/failure"
 )
 
 // Doctor command handle
@@ -17,10 +20,16 @@ func Doctor(ctx context.Contexter) error {
 	if host == "" {
 		host = "localhost"
 	}
-	if err := doctor.New(host, user, password).Start(); err != nil {
-		log.Warnf("machine %s is in dirty state: %v", host, err)
-	} else {
-		log.Infof("machine %s is in healthy state: %s", host, constants.CheckedSymbol)
+	d := doctor.New(host, user, password)
+	// legacy fx agent is checked only when current infrastructure uses it
+	if fxConfig, ok := ctx.Get("config").(*config.Config); ok {
+		if fxConfig.Clouds[fxConfig.CurrentCloud]["agent"] == "true" {
+			d = d.WithAgent()
+		}
 	}
+	if err := d.Start(); err != nil {
+		return failure.Provision(fmt.Errorf("machine %s is in dirty state: %v", host, err))
+	}
+	log.Infof("machine %s is in healthy state: %s", host, constants.CheckedSymbol)
 	return nil
 }
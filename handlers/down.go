@@ -1,14 +1,52 @@
 package handlers
 
 import (
+	"fmt"
+	"os"
+
 	"github.com/metrue/fx/context"
 	"github.com/metrue/fx/infra"
+	"github.com/metrue/fx/middlewares"
+	"github.com/metrue/fx/pkg/failure"
+	"github.com/metrue/fx/types"
 )
 
 // Down command handle
 func Down(ctx context.Contexter) (err error) {
-	services := ctx.Get("services").([]string)
+	defer func() {
+		err = failure.Deploy(err)
+	}()
+
+	services, _ := ctx.Get("services").([]string)
 	runner := ctx.Get("deployer").(infra.Deployer)
+	dryRun, _ := ctx.Get("dry_run").(bool)
+	if all, _ := ctx.Get("all").(bool); all {
+		resources, err := runner.Resources(ctx.GetContext(), "")
+		if err != nil {
+			return err
+		}
+		// unlabeled resources are listed by dry run, but they're destroyed only when they're included
+		if include, _ := ctx.Get("include_unlabeled").(bool); !include && !dryRun {
+			resources = types.Labeled(resources)
+		}
+		services = servicesOf(resources)
+	}
+
+	if dryRun {
+		resources := []types.Resource{}
+		for _, svc := range services {
+			rs, err := runner.Resources(ctx.GetContext(), svc)
+			if err != nil {
+				return err
+			}
+			if len(rs) == 0 {
+				return failure.NotFound(fmt.Errorf("no such service %s", svc))
+			}
+			resources = append(resources, rs...)
+		}
+		return middlewares.RendererOf(ctx).Resources(os.Stdout, resources)
+	}
+
 	for _, svc := range services {
 		if err := runner.Destroy(ctx.GetContext(), svc); err != nil {
 			return err
@@ -16,3 +54,16 @@ func Down(ctx context.Contexter) (err error) {
 	}
 	return nil
 }
+
+// servicesOf names of the services resources belong to
+func servicesOf(resources []types.Resource) []string {
+	services := []string{}
+	seen := map[string]bool{}
+	for _, r := range resources {
+		if !seen[r.Service] {
+			seen[r.Service] = true
+			services = append(services, r.Service)
+		}
+	}
+	return services
+}
@@ -7,21 +7,97 @@ import (
 	"github.com/golang/mock/gomock"
 	mockCtx "github.com/metrue/fx/context/mocks"
 	mockDeployer "github.com/metrue/fx/infra/mocks"
+	"github.com/metrue/fx/types"
 )
 
 func TestDown(t *testing.T) {
-	ctrl := gomock.NewController(t)
-	defer ctrl.Finish()
-
-	ctx := mockCtx.NewMockContexter(ctrl)
-	deployer := mockDeployer.NewMockDeployer(ctrl)
-
-	services := []string{"sample-name"}
-	ctx.EXPECT().Get("services").Return(services)
-	ctx.EXPECT().Get("deployer").Return(deployer)
-	ctx.EXPECT().GetContext().Return(context.Background())
-	deployer.EXPECT().Destroy(gomock.Any(), services[0]).Return(nil)
-	if err := Down(ctx); err != nil {
-		t.Fatal(err)
-	}
+	t.Run("services", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ctx := mockCtx.NewMockContexter(ctrl)
+		deployer := mockDeployer.NewMockDeployer(ctrl)
+
+		services := []string{"sample-name"}
+		ctx.EXPECT().Get("services").Return(services)
+		ctx.EXPECT().Get("deployer").Return(deployer)
+		ctx.EXPECT().Get("all").Return(false)
+		ctx.EXPECT().Get("dry_run").Return(false)
+		ctx.EXPECT().GetContext().Return(context.Background())
+		deployer.EXPECT().Destroy(gomock.Any(), services[0]).Return(nil)
+		if err := Down(ctx); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	t.Run("all", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ctx := mockCtx.NewMockContexter(ctrl)
+		deployer := mockDeployer.NewMockDeployer(ctrl)
+
+		resources := []types.Resource{
+			types.Resource{Kind: "Deployment", Name: "hello", Service: "hello"},
+			types.Resource{Kind: "ConfigMap", Name: "hello", Service: "hello"},
+			types.Resource{Kind: "ConfigMap", Name: "orphan", Service: "orphan", Unlabeled: true},
+		}
+		ctx.EXPECT().Get("services").Return(nil)
+		ctx.EXPECT().Get("deployer").Return(deployer)
+		ctx.EXPECT().Get("all").Return(true)
+		ctx.EXPECT().Get("dry_run").Return(false)
+		ctx.EXPECT().Get("include_unlabeled").Return(false)
+		ctx.EXPECT().GetContext().Return(context.Background()).Times(2)
+		deployer.EXPECT().Resources(gomock.Any(), "").Return(resources, nil)
+		deployer.EXPECT().Destroy(gomock.Any(), "hello").Return(nil)
+		if err := Down(ctx); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	t.Run("all with unlabeled", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ctx := mockCtx.NewMockContexter(ctrl)
+		deployer := mockDeployer.NewMockDeployer(ctrl)
+
+		resources := []types.Resource{
+			types.Resource{Kind: "Deployment", Name: "hello", Service: "hello"},
+			types.Resource{Kind: "ConfigMap", Name: "orphan", Service: "orphan", Unlabeled: true},
+		}
+		ctx.EXPECT().Get("services").Return(nil)
+		ctx.EXPECT().Get("deployer").Return(deployer)
+		ctx.EXPECT().Get("all").Return(true)
+		ctx.EXPECT().Get("dry_run").Return(false)
+		ctx.EXPECT().Get("include_unlabeled").Return(true)
+		ctx.EXPECT().GetContext().Return(context.Background()).Times(3)
+		deployer.EXPECT().Resources(gomock.Any(), "").Return(resources, nil)
+		deployer.EXPECT().Destroy(gomock.Any(), "hello").Return(nil)
+		deployer.EXPECT().Destroy(gomock.Any(), "orphan").Return(nil)
+		if err := Down(ctx); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	t.Run("dry run", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ctx := mockCtx.NewMockContexter(ctrl)
+		deployer := mockDeployer.NewMockDeployer(ctrl)
+
+		ctx.EXPECT().Get("services").Return([]string{"hello", "missing"})
+		ctx.EXPECT().Get("deployer").Return(deployer)
+		ctx.EXPECT().Get("all").Return(false)
+		ctx.EXPECT().Get("dry_run").Return(true)
+		ctx.EXPECT().GetContext().Return(context.Background()).Times(2)
+		deployer.EXPECT().Resources(gomock.Any(), "hello").Return([]types.Resource{
+			types.Resource{Kind: "Deployment", Name: "hello", Service: "hello"},
+		}, nil)
+		deployer.EXPECT().Resources(gomock.Any(), "missing").Return([]types.Resource{}, nil)
+		if err := Down(ctx); err == nil {
+			t.Fatalf("should get error when service does not exist")
+		}
+	})
 }
@@ -0,0 +1,55 @@
+package handlers
+
+import (
+	"fmt"
+
+	containerruntimes "github.com/metrue/fx/container_runtimes"
+	"github.com/metrue/fx/context"
+	"github.com/metrue/fx/pkg/gateway"
+	"github.com/metrue/fx/pkg/spinner"
+	"github.com/metrue/fx/types"
+)
+
+// StartGateway start fx gateway on Docker infrastructure, it routes /<function name>/... to functions
+func StartGateway(ctx context.Contexter) (err error) {
+	const task = "starting gateway"
+	spinner.Start(task)
+	defer func() {
+		spinner.Stop(task, err)
+	}()
+
+	docker, ok := ctx.Get("docker").(containerruntimes.ContainerRuntime)
+	if !ok {
+		return fmt.Errorf("gateway is only supported on docker infrastructure")
+	}
+	if gateway.IsRunning(ctx.GetContext(), docker) {
+		return fmt.Errorf("gateway is already running")
+	}
+
+	port := ctx.Get("port").(int)
+	bindings := []types.PortBinding{
+		types.PortBinding{
+			ServiceBindingPort:  int32(port),
+			ContainerExposePort: gateway.Port,
+		},
+	}
+	if err := docker.StartContainer(ctx.GetContext(), gateway.ContainerName, gateway.Image, bindings, types.DeployOptions{}); err != nil {
+		return err
+	}
+	return gateway.Refresh(ctx.GetContext(), docker)
+}
+
+// StopGateway stop fx gateway
+func StopGateway(ctx context.Contexter) (err error) {
+	const task = "stopping gateway"
+	spinner.Start(task)
+	defer func() {
+		spinner.Stop(task, err)
+	}()
+
+	docker, ok := ctx.Get("docker").(containerruntimes.ContainerRuntime)
+	if !ok {
+		return fmt.Errorf("gateway is only supported on docker infrastructure")
+	}
+	return docker.StopContainer(ctx.GetContext(), gateway.ContainerName)
+}
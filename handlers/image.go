@@ -10,6 +10,7 @@ import (
 	containerruntimes "github.com/metrue/fx/container_runtimes"
 	"github.com/metrue/fx/context"
 	"github.com/metrue/fx/packer"
+	"github.com/metrue/fx/pkg/failure"
 	"github.com/metrue/fx/pkg/spinner"
 	"github.com/metrue/fx/utils"
 	"github.com/otiai10/copy"
@@ -20,6 +21,7 @@ func BuildImage(ctx context.Contexter) (err error) {
 	spinner.Start("building")
 	defer func() {
 		spinner.Stop("building", err)
+		err = failure.Build(err)
 	}()
 	workdir := fmt.Sprintf("/tmp/fx-%d", time.Now().Unix())
 	defer os.RemoveAll(workdir)
@@ -27,7 +29,7 @@ func BuildImage(ctx context.Contexter) (err error) {
 	sources := ctx.Get("sources").([]string)
 
 	if len(sources) == 0 {
-		return fmt.Errorf("source file/directory of function required")
+		return failure.Usage(fmt.Errorf("source file/directory of function required"))
 	}
 	if len(sources) == 1 &&
 		utils.IsDir(sources[0]) &&
@@ -43,7 +45,7 @@ func BuildImage(ctx context.Contexter) (err error) {
 
 	docker := ctx.Get("docker").(containerruntimes.ContainerRuntime)
 	nameWithTag := ctx.Get("tag").(string) + ":latest"
-	if err := docker.BuildImage(ctx.GetContext(), workdir, nameWithTag); err != nil {
+	if err := docker.BuildImage(ctx.GetContext(), workdir, nameWithTag, nil); err != nil {
 		return err
 	}
 	log.Infof("image built: %s %v", nameWithTag, constants.CheckedSymbol)
@@ -52,11 +54,15 @@ func BuildImage(ctx context.Contexter) (err error) {
 
 // ExportImage export service's code into a directory
 func ExportImage(ctx context.Contexter) (err error) {
+	defer func() {
+		err = failure.Build(err)
+	}()
+
 	outputDir := ctx.Get("output").(string)
 	sources := ctx.Get("sources").([]string)
 
 	if len(sources) == 0 {
-		return fmt.Errorf("source file/directory of function required")
+		return failure.Usage(fmt.Errorf("source file/directory of function required"))
 	}
 	if len(sources) == 1 &&
 		utils.IsDir(sources[0]) &&
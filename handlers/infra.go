@@ -1,13 +1,18 @@
 package handlers
 
 import (
+	"encoding/json"
 	"fmt"
+	"net/url"
 	"strings"
 
 	"github.com/metrue/fx/config"
+	dockerHTTP "github.com/metrue/fx/container_runtimes/docker/http"
+	"github.com/metrue/fx/container_runtimes/podman"
 	"github.com/metrue/fx/context"
 	dockerInfra "github.com/metrue/fx/infra/docker"
 	"github.com/metrue/fx/infra/k8s"
+	"github.com/metrue/fx/pkg/failure"
 	"github.com/metrue/fx/pkg/spinner"
 )
 
@@ -39,7 +44,7 @@ func setupK8S(masterInfo string, agentsInfo string) ([]byte, error) {
 	return k8sOperator.Provision()
 }
 
-func setupDocker(hostInfo string) ([]byte, error) {
+func setupDocker(hostInfo string, agent bool) ([]byte, error) {
 	info := strings.Split(hostInfo, "@")
 	if len(info) != 2 {
 		return nil, fmt.Errorf("incorrect master info, should be <user>@<ip> format")
@@ -47,33 +52,47 @@ func setupDocker(hostInfo string) ([]byte, error) {
 	user := info[1]
 	host := info[0]
 	dockr := dockerInfra.CreateProvisioner(user, host)
+	dockr.Agent = agent
 	return dockr.Provision()
 }
 
+// setupDockerEndpoint verify docker engine on endpoint, functions are called on the host of endpoint
+func setupDockerEndpoint(endpoint string, tlsOptions *dockerHTTP.TLSOptions) ([]byte, error) {
+	if _, err := dockerHTTP.Dial(endpoint, tlsOptions); err != nil {
+		return nil, fmt.Errorf("could not connect docker engine on %s: %v", endpoint, err)
+	}
+	host := "127.0.0.1"
+	if u, err := url.Parse(endpoint); err == nil && u.Scheme == "tcp" {
+		host = u.Hostname()
+	}
+	return json.Marshal(map[string]string{"ip": host})
+}
+
 // Setup infra
 func Setup(ctx context.Contexter) (err error) {
 	const task = "setup infra"
 	spinner.Start(task)
 	defer func() {
 		spinner.Stop(task, err)
+		err = failure.Provision(err)
 	}()
 
 	cli := ctx.GetCliContext()
 	typ := cli.String("type")
 	name := cli.String("name")
 	if name == "" {
-		return fmt.Errorf("name required")
+		return failure.Usage(fmt.Errorf("name required"))
 	}
 	if typ == "docker" {
-		if cli.String("host") == "" {
-			return fmt.Errorf("host required, eg. 'root@123.1.2.12'")
+		if cli.String("host") == "" && cli.String("endpoint") == "" {
+			return failure.Usage(fmt.Errorf("host or endpoint required, eg. 'root@123.1.2.12' or 'tcp://123.1.2.12:2376'"))
 		}
 	} else if typ == "k8s" {
 		if cli.String("master") == "" {
-			return fmt.Errorf("master required, eg. 'root@123.1.2.12'")
+			return failure.Usage(fmt.Errorf("master required, eg. 'root@123.1.2.12'"))
 		}
-	} else {
-		return fmt.Errorf("invalid type, 'docker' and 'k8s' support")
+	} else if typ != "podman" {
+		return failure.Usage(fmt.Errorf("invalid type, 'docker', 'k8s' and 'podman' support"))
 	}
 
 	fxConfig := ctx.Get("config").(*config.Config)
@@ -84,13 +103,43 @@ func Setup(ctx context.Contexter) (err error) {
 		if err != nil {
 			return err
 		}
-		return fxConfig.AddK8SCloud(name, kubeconf)
+		if err := fxConfig.AddK8SCloud(name, kubeconf); err != nil {
+			return err
+		}
+		return setK8SOptions(fxConfig, name, cli)
 	case "docker":
-		config, err := setupDocker(cli.String("host"))
+		if endpoint := cli.String("endpoint"); endpoint != "" {
+			var tlsOptions *dockerHTTP.TLSOptions
+			if cli.String("tlscacert") != "" || cli.String("tlscert") != "" || cli.String("tlskey") != "" {
+				tlsOptions = &dockerHTTP.TLSOptions{
+					CACert: cli.String("tlscacert"),
+					Cert:   cli.String("tlscert"),
+					Key:    cli.String("tlskey"),
+				}
+			}
+			config, err := setupDockerEndpoint(endpoint, tlsOptions)
+			if err != nil {
+				return err
+			}
+			if err := fxConfig.AddDockerCloud(name, config); err != nil {
+				return err
+			}
+			return fxConfig.SetDockerEndpoint(name, endpoint, cli.String("tlscacert"), cli.String("tlscert"), cli.String("tlskey"))
+		}
+		config, err := setupDocker(cli.String("host"), cli.Bool("agent"))
+		if err != nil {
+			return err
+		}
+		if err := fxConfig.AddDockerCloud(name, config); err != nil {
+			return err
+		}
+		return fxConfig.SetIgnoreHostKey(name, cli.Bool("insecure-ignore-host-key"))
+	case "podman":
+		runtime, err := podman.Create(cli.String("socket"))
 		if err != nil {
 			return err
 		}
-		return fxConfig.AddDockerCloud(name, config)
+		return fxConfig.AddPodmanCloud(name, runtime.Socket())
 	}
 	return nil
 }
@@ -0,0 +1,48 @@
+package handlers
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/apex/log"
+	"github.com/metrue/fx/constants"
+	"github.com/metrue/fx/context"
+	"github.com/metrue/fx/packer"
+	"github.com/metrue/fx/pkg/failure"
+)
+
+// Init write a function of given language into a directory named with the function,
+// with its handler, a dependency manifest and a sample test. Languages are listed with --list
+func Init(ctx context.Contexter) error {
+	cli := ctx.GetCliContext()
+	if cli.Bool("list") {
+		for _, lang := range packer.Languages() {
+			fmt.Println(lang)
+		}
+		return nil
+	}
+
+	name := cli.Args().First()
+	if name == "" {
+		return failure.Usage(fmt.Errorf("name of function required"))
+	}
+	lang := cli.String("lang")
+	if !contains(packer.Languages(), lang) {
+		return failure.Usage(fmt.Errorf("language of function should be one of %s", strings.Join(packer.Languages(), ", ")))
+	}
+	if err := packer.Scaffold(name, lang, filepath.Base(name)); err != nil {
+		return err
+	}
+	log.Infof("%s function %s created, deploy it with 'fx up --name %s %s': %s", lang, name, filepath.Base(name), name, constants.CheckedSymbol)
+	return nil
+}
+
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
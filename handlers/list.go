@@ -1,9 +1,11 @@
 package handlers
 
 import (
+	"os"
+
 	"github.com/metrue/fx/context"
 	"github.com/metrue/fx/infra"
-	"github.com/metrue/fx/pkg/render"
+	"github.com/metrue/fx/middlewares"
 )
 
 // List command handle
@@ -16,6 +18,5 @@ func List(ctx context.Contexter) (err error) {
 		return err
 	}
 
-	render.Table(services)
-	return nil
+	return middlewares.RendererOf(ctx).Services(os.Stdout, services)
 }
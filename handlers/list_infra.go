@@ -1,19 +1,15 @@
 package handlers
 
 import (
-	"fmt"
+	"os"
 
 	"github.com/metrue/fx/config"
 	"github.com/metrue/fx/context"
+	"github.com/metrue/fx/middlewares"
 )
 
 // ListInfra list infra
 func ListInfra(ctx context.Contexter) (err error) {
 	fxConfig := ctx.Get("config").(*config.Config)
-	conf, err := fxConfig.View()
-	if err != nil {
-		return err
-	}
-	fmt.Println(string(conf))
-	return nil
+	return middlewares.RendererOf(ctx).Infras(os.Stdout, fxConfig.Infras())
 }
@@ -0,0 +1,17 @@
+package handlers
+
+import (
+	"os"
+
+	"github.com/metrue/fx/context"
+	"github.com/metrue/fx/infra"
+	"github.com/metrue/fx/types"
+)
+
+// Logs command handle
+func Logs(ctx context.Contexter) error {
+	service := ctx.Get("service").(string)
+	options := ctx.Get("log_options").(types.LogOptions)
+	deployer := ctx.Get("deployer").(infra.Deployer)
+	return deployer.Logs(ctx.GetContext(), service, options, os.Stdout)
+}
@@ -0,0 +1,30 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	mockCtx "github.com/metrue/fx/context/mocks"
+	mockDeployer "github.com/metrue/fx/infra/mocks"
+	"github.com/metrue/fx/types"
+)
+
+func TestLogs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := mockCtx.NewMockContexter(ctrl)
+	deployer := mockDeployer.NewMockDeployer(ctrl)
+
+	name := "sample-name"
+	options := types.LogOptions{Follow: true, Tail: "all"}
+	ctx.EXPECT().Get("service").Return(name)
+	ctx.EXPECT().Get("log_options").Return(options)
+	ctx.EXPECT().Get("deployer").Return(deployer)
+	ctx.EXPECT().GetContext().Return(context.Background())
+	deployer.EXPECT().Logs(gomock.Any(), name, options, gomock.Any()).Return(nil)
+	if err := Logs(ctx); err != nil {
+		t.Fatal(err)
+	}
+}
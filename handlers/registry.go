@@ -0,0 +1,72 @@
+package handlers
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/apex/log"
+	"github.com/metrue/fx/constants"
+	"github.com/metrue/fx/context"
+	"github.com/metrue/fx/pkg/failure"
+	"github.com/metrue/fx/registry"
+)
+
+// RegistryLogin verify credentials with registry and save them, Docker Hub is the registry when server is not given,
+// password is read from stdin with --password-stdin, so that it does not have to be in shell history
+func RegistryLogin(ctx context.Contexter) error {
+	cli := ctx.GetCliContext()
+	server := cli.Args().First()
+	if server == "" {
+		server = registry.DockerHub
+	}
+	username := cli.String("username")
+	password := cli.String("password")
+	if username == "" {
+		return failure.Usage(fmt.Errorf("username of registry required"))
+	}
+	if cli.Bool("password-stdin") {
+		if password != "" {
+			return failure.Usage(fmt.Errorf("--password and --password-stdin are mutually exclusive"))
+		}
+		body, err := ioutil.ReadAll(os.Stdin)
+		if err != nil {
+			return err
+		}
+		password = strings.TrimRight(string(body), "\r\n")
+	}
+	if password == "" {
+		return failure.Usage(fmt.Errorf("password of registry required, by --password or --password-stdin"))
+	}
+
+	if err := registry.Verify(ctx.GetContext(), server, username, password); err != nil {
+		return err
+	}
+	store, err := registry.LoadDefault()
+	if err != nil {
+		return err
+	}
+	if err := store.Login(server, username, password); err != nil {
+		return err
+	}
+	log.Infof("logged in to %s: %s", registry.Normalize(server), constants.CheckedSymbol)
+	return nil
+}
+
+// RegistryLogout remove saved credentials of registry, Docker Hub is the registry when server is not given
+func RegistryLogout(ctx context.Contexter) error {
+	server := ctx.GetCliContext().Args().First()
+	if server == "" {
+		server = registry.DockerHub
+	}
+	store, err := registry.LoadDefault()
+	if err != nil {
+		return err
+	}
+	if err := store.Logout(server); err != nil {
+		return err
+	}
+	log.Infof("logged out of %s: %s", registry.Normalize(server), constants.CheckedSymbol)
+	return nil
+}
@@ -0,0 +1,24 @@
+package handlers
+
+import (
+	"fmt"
+
+	"github.com/metrue/fx/config"
+	"github.com/metrue/fx/context"
+	"github.com/metrue/fx/pkg/failure"
+)
+
+// RemoveInfra remove infra, the current one is removed only with --force
+func RemoveInfra(ctx context.Contexter) error {
+	fxConfig := ctx.Get("config").(*config.Config)
+	cli := ctx.GetCliContext()
+	if !cli.Args().Present() {
+		return failure.Usage(fmt.Errorf("name of infrastructure required"))
+	}
+	for _, name := range cli.Args() {
+		if err := fxConfig.Remove(name, cli.Bool("force")); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,19 @@
+package handlers
+
+import (
+	"fmt"
+
+	"github.com/metrue/fx/config"
+	"github.com/metrue/fx/context"
+	"github.com/metrue/fx/pkg/failure"
+)
+
+// RenameInfra rename infra
+func RenameInfra(ctx context.Contexter) error {
+	fxConfig := ctx.Get("config").(*config.Config)
+	cli := ctx.GetCliContext()
+	if len(cli.Args()) != 2 {
+		return failure.Usage(fmt.Errorf("name and new name of infrastructure required"))
+	}
+	return fxConfig.Rename(cli.Args().Get(0), cli.Args().Get(1))
+}
@@ -0,0 +1,77 @@
+package handlers
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/apex/log"
+	"github.com/metrue/fx/constants"
+	"github.com/metrue/fx/context"
+	"github.com/metrue/fx/pkg/failure"
+	"github.com/metrue/fx/secret"
+)
+
+// SetSecret set a secret, value is read from stdin when it's not given as argument,
+// so that it does not have to be in shell history
+func SetSecret(ctx context.Contexter) error {
+	cli := ctx.GetCliContext()
+	name := cli.Args().First()
+	if name == "" {
+		return failure.Usage(fmt.Errorf("secret name required"))
+	}
+	value := cli.Args().Get(1)
+	if len(cli.Args()) < 2 {
+		body, err := ioutil.ReadAll(os.Stdin)
+		if err != nil {
+			return err
+		}
+		value = strings.TrimRight(string(body), "\r\n")
+	}
+
+	store, err := secret.LoadDefault()
+	if err != nil {
+		return err
+	}
+	if err := store.Set(name, value); err != nil {
+		return err
+	}
+	log.Infof("secret %s set: %s", name, constants.CheckedSymbol)
+	return nil
+}
+
+// ListSecrets list names of secrets, values are never printed
+func ListSecrets(ctx context.Contexter) error {
+	store, err := secret.LoadDefault()
+	if err != nil {
+		return err
+	}
+	names, err := store.List()
+	if err != nil {
+		return err
+	}
+	for _, name := range names {
+		fmt.Println(name)
+	}
+	return nil
+}
+
+// RemoveSecret remove secrets
+func RemoveSecret(ctx context.Contexter) error {
+	cli := ctx.GetCliContext()
+	if len(cli.Args()) == 0 {
+		return failure.Usage(fmt.Errorf("secret name required"))
+	}
+	store, err := secret.LoadDefault()
+	if err != nil {
+		return err
+	}
+	for _, name := range cli.Args() {
+		if err := store.Remove(name); err != nil {
+			return err
+		}
+		log.Infof("secret %s removed: %s", name, constants.CheckedSymbol)
+	}
+	return nil
+}
@@ -0,0 +1,30 @@
+package handlers
+
+import (
+	"os"
+
+	"github.com/apex/log"
+	"github.com/metrue/fx/config"
+	"github.com/metrue/fx/context"
+	"github.com/metrue/fx/infra/k8s"
+	"github.com/metrue/fx/middlewares"
+)
+
+// ShowInfra show details of infra, current one when no name given, credentials are redacted
+func ShowInfra(ctx context.Contexter) error {
+	fxConfig := ctx.Get("config").(*config.Config)
+	name := ctx.GetCliContext().Args().First()
+	if name == "" {
+		name = fxConfig.CurrentCloud
+	}
+	infra, err := fxConfig.Infra(name)
+	if err != nil {
+		return err
+	}
+	if infra.Kubeconfig != "" {
+		if infra.Server, err = k8s.Server(infra.Kubeconfig); err != nil {
+			log.Warnf("could not read API server from %s: %v", infra.Kubeconfig, err)
+		}
+	}
+	return middlewares.RendererOf(ctx).Infra(os.Stdout, infra)
+}
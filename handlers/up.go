@@ -1,14 +1,27 @@
 package handlers
 
 import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/metrue/fx/config"
 	"github.com/metrue/fx/context"
 	"github.com/metrue/fx/infra"
-	"github.com/metrue/fx/pkg/render"
+	"github.com/metrue/fx/middlewares"
+	"github.com/metrue/fx/pkg/failure"
+	"github.com/metrue/fx/pkg/healthcheck"
+	"github.com/metrue/fx/pkg/spinner"
 	"github.com/metrue/fx/types"
 )
 
 // Up command handle
 func Up(ctx context.Contexter) (err error) {
+	defer func() {
+		err = failure.Deploy(err)
+	}()
+
 	fn, ok := ctx.Get("data").(string)
 	if !ok {
 		fn = ""
@@ -20,13 +33,40 @@ func Up(ctx context.Contexter) (err error) {
 	name := ctx.Get("name").(string)
 	deployer := ctx.Get("deployer").(infra.Deployer)
 	bindings := ctx.Get("bindings").([]types.PortBinding)
+	env, _ := ctx.Get("env").(map[string]string)
+	secrets, _ := ctx.Get("secrets").(map[string]string)
+	replicas, _ := ctx.Get("replicas").(int32)
+	memory, _ := ctx.Get("memory").(int64)
+	cpus, _ := ctx.Get("cpus").(float64)
+	restart, _ := ctx.Get("restart").(string)
+	healthPath, _ := ctx.Get("health_path").(string)
+	options := types.DeployOptions{
+		Replicas:   replicas,
+		Env:        env,
+		Secrets:    secrets,
+		Memory:     memory,
+		CPUs:       cpus,
+		Restart:    restart,
+		HealthPath: healthPath,
+	}
 
-	if err := deployer.Deploy(
+	// update the service in place when it's already deployed
+	deploy := deployer.Deploy
+	if _, err := deployer.GetStatus(ctx.GetContext(), name); err == nil {
+		deploy = deployer.Update
+		// service keeps its host ports on Docker when no port is given
+		random, _ := ctx.Get("random_port").(bool)
+		if cloudType, _ := ctx.Get("cloud_type").(string); random && cloudType != config.CloudTypeK8S {
+			bindings = []types.PortBinding{}
+		}
+	}
+	if err := deploy(
 		ctx.GetContext(),
 		fn,
 		name,
 		image,
 		bindings,
+		options,
 	); err != nil {
 		return err
 	}
@@ -35,6 +75,35 @@ func Up(ctx context.Contexter) (err error) {
 	if err != nil {
 		return err
 	}
-	render.Table([]types.Service{service})
+	if check, _ := ctx.Get("healthcheck").(bool); check {
+		if err := waitHealthy(ctx, deployer, service, healthPath); err != nil {
+			return err
+		}
+	}
+	ctx.Set("service", service)
+	if quiet, _ := ctx.Get("quiet").(bool); !quiet {
+		return middlewares.RendererOf(ctx).Services(os.Stdout, []types.Service{service})
+	}
 	return nil
 }
+
+// waitHealthy wait until service answers HTTP on its endpoint, the endpoint is checked by deployer itself on Kubernetes
+// since it's usually not reachable from outside of cluster
+func waitHealthy(ctx context.Contexter, deployer infra.Deployer, service types.Service, path string) (err error) {
+	task := "health checking " + service.Name
+	spinner.Start(task)
+	defer func() {
+		spinner.Stop(task, err)
+	}()
+
+	timeout, _ := ctx.Get("healthcheck_timeout").(time.Duration)
+	if timeout == 0 {
+		timeout = healthcheck.DefaultTimeout
+	}
+	endpoint := ""
+	if cloudType, _ := ctx.Get("cloud_type").(string); cloudType != config.CloudTypeK8S {
+		endpoint = fmt.Sprintf("http://%s:%d", callHost(ctx, service.Host), service.Port)
+	}
+	name := strings.TrimPrefix(service.Name, "/")
+	return healthcheck.Wait(ctx.GetContext(), deployer, name, endpoint, path, timeout)
+}
@@ -2,39 +2,164 @@ package handlers
 
 import (
 	"context"
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
+	"github.com/metrue/fx/config"
 	mockCtx "github.com/metrue/fx/context/mocks"
 	mockDeployer "github.com/metrue/fx/infra/mocks"
 	"github.com/metrue/fx/types"
 )
 
 func TestUp(t *testing.T) {
-	ctrl := gomock.NewController(t)
-	defer ctrl.Finish()
-
-	ctx := mockCtx.NewMockContexter(ctrl)
-	deployer := mockDeployer.NewMockDeployer(ctrl)
-
 	bindings := []types.PortBinding{}
 	name := "sample-name"
 	image := "sample-image"
 	data := "sample-data"
-	ctx.EXPECT().Get("name").Return(name)
-	ctx.EXPECT().Get("image").Return(image)
-	ctx.EXPECT().Get("deployer").Return(deployer)
-	ctx.EXPECT().Get("bindings").Return(bindings)
-	ctx.EXPECT().Get("data").Return(data)
-	ctx.EXPECT().GetContext().Return(context.Background()).Times(2)
-	deployer.EXPECT().Deploy(gomock.Any(), data, name, image, bindings).Return(nil)
-	deployer.EXPECT().GetStatus(gomock.Any(), name).Return(types.Service{
+	env := map[string]string{"GREETING": "hello"}
+	options := types.DeployOptions{Replicas: 2, Env: env, Memory: 1024, CPUs: 0.5, Restart: "always", HealthPath: "/health"}
+	service := types.Service{
 		ID:   "id-1",
 		Name: name,
 		Host: "127.0.0.1",
 		Port: 2100,
-	}, nil)
-	if err := Up(ctx); err != nil {
-		t.Fatal(err)
 	}
+
+	t.Run("deploy", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ctx := mockCtx.NewMockContexter(ctrl)
+		deployer := mockDeployer.NewMockDeployer(ctrl)
+
+		ctx.EXPECT().Get("name").Return(name)
+		ctx.EXPECT().Get("image").Return(image)
+		ctx.EXPECT().Get("deployer").Return(deployer)
+		ctx.EXPECT().Get("bindings").Return(bindings)
+		ctx.EXPECT().Get("data").Return(data)
+		ctx.EXPECT().Get("env").Return(env)
+		ctx.EXPECT().Get("secrets").Return(nil)
+		ctx.EXPECT().Get("replicas").Return(int32(2))
+		ctx.EXPECT().Get("memory").Return(int64(1024))
+		ctx.EXPECT().Get("cpus").Return(0.5)
+		ctx.EXPECT().Get("restart").Return("always")
+		ctx.EXPECT().Get("health_path").Return("/health")
+		ctx.EXPECT().Get("healthcheck").Return(nil)
+		ctx.EXPECT().Set("service", service)
+		ctx.EXPECT().Get("quiet").Return(nil)
+		ctx.EXPECT().Get("renderer").Return(nil)
+		ctx.EXPECT().GetContext().Return(context.Background()).Times(3)
+		gomock.InOrder(
+			deployer.EXPECT().GetStatus(gomock.Any(), name).Return(types.Service{}, fmt.Errorf("not found")),
+			deployer.EXPECT().Deploy(gomock.Any(), data, name, image, bindings, options).Return(nil),
+			deployer.EXPECT().GetStatus(gomock.Any(), name).Return(service, nil),
+		)
+		if err := Up(ctx); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	t.Run("update", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ctx := mockCtx.NewMockContexter(ctrl)
+		deployer := mockDeployer.NewMockDeployer(ctrl)
+
+		ctx.EXPECT().Get("name").Return(name)
+		ctx.EXPECT().Get("image").Return(image)
+		ctx.EXPECT().Get("deployer").Return(deployer)
+		ctx.EXPECT().Get("bindings").Return(bindings)
+		ctx.EXPECT().Get("data").Return(data)
+		ctx.EXPECT().Get("env").Return(env)
+		ctx.EXPECT().Get("secrets").Return(nil)
+		ctx.EXPECT().Get("replicas").Return(int32(2))
+		ctx.EXPECT().Get("memory").Return(int64(1024))
+		ctx.EXPECT().Get("cpus").Return(0.5)
+		ctx.EXPECT().Get("restart").Return("always")
+		ctx.EXPECT().Get("health_path").Return("/health")
+		ctx.EXPECT().Get("healthcheck").Return(nil)
+		ctx.EXPECT().Get("random_port").Return(nil)
+		ctx.EXPECT().Get("cloud_type").Return(nil)
+		ctx.EXPECT().Set("service", service)
+		ctx.EXPECT().Get("quiet").Return(nil)
+		ctx.EXPECT().Get("renderer").Return(nil)
+		ctx.EXPECT().GetContext().Return(context.Background()).Times(3)
+		deployer.EXPECT().GetStatus(gomock.Any(), name).Return(service, nil).Times(2)
+		deployer.EXPECT().Update(gomock.Any(), data, name, image, bindings, options).Return(nil)
+		if err := Up(ctx); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	t.Run("update on random port", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ctx := mockCtx.NewMockContexter(ctrl)
+		deployer := mockDeployer.NewMockDeployer(ctrl)
+
+		random := []types.PortBinding{
+			types.PortBinding{ServiceBindingPort: 40000, ContainerExposePort: 3000},
+		}
+		ctx.EXPECT().Get("name").Return(name)
+		ctx.EXPECT().Get("image").Return(image)
+		ctx.EXPECT().Get("deployer").Return(deployer)
+		ctx.EXPECT().Get("bindings").Return(random)
+		ctx.EXPECT().Get("data").Return(data)
+		ctx.EXPECT().Get("env").Return(env)
+		ctx.EXPECT().Get("secrets").Return(nil)
+		ctx.EXPECT().Get("replicas").Return(int32(2))
+		ctx.EXPECT().Get("memory").Return(int64(1024))
+		ctx.EXPECT().Get("cpus").Return(0.5)
+		ctx.EXPECT().Get("restart").Return("always")
+		ctx.EXPECT().Get("health_path").Return("/health")
+		ctx.EXPECT().Get("healthcheck").Return(nil)
+		ctx.EXPECT().Get("random_port").Return(true)
+		ctx.EXPECT().Get("cloud_type").Return(config.CloudTypeDocker)
+		ctx.EXPECT().Set("service", service)
+		ctx.EXPECT().Get("quiet").Return(nil)
+		ctx.EXPECT().Get("renderer").Return(nil)
+		ctx.EXPECT().GetContext().Return(context.Background()).Times(3)
+		deployer.EXPECT().GetStatus(gomock.Any(), name).Return(service, nil).Times(2)
+		// service keeps its ports
+		deployer.EXPECT().Update(gomock.Any(), data, name, image, []types.PortBinding{}, options).Return(nil)
+		if err := Up(ctx); err != nil {
+			t.Fatal(err)
+		}
+	})
+	t.Run("unhealthy", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ctx := mockCtx.NewMockContexter(ctrl)
+		deployer := mockDeployer.NewMockDeployer(ctrl)
+
+		ctx.EXPECT().Get("name").Return(name)
+		ctx.EXPECT().Get("image").Return(image)
+		ctx.EXPECT().Get("deployer").Return(deployer)
+		ctx.EXPECT().Get("bindings").Return(bindings)
+		ctx.EXPECT().Get("data").Return(data)
+		ctx.EXPECT().Get("env").Return(env)
+		ctx.EXPECT().Get("secrets").Return(nil)
+		ctx.EXPECT().Get("replicas").Return(int32(2))
+		ctx.EXPECT().Get("memory").Return(int64(1024))
+		ctx.EXPECT().Get("cpus").Return(0.5)
+		ctx.EXPECT().Get("restart").Return("always")
+		ctx.EXPECT().Get("health_path").Return("/health")
+		ctx.EXPECT().Get("healthcheck").Return(true)
+		ctx.EXPECT().Get("healthcheck_timeout").Return(time.Second)
+		ctx.EXPECT().Get("random_port").Return(nil)
+		ctx.EXPECT().Get("cloud_type").Return(config.CloudTypeK8S).Times(2)
+		ctx.EXPECT().GetContext().Return(context.Background()).Times(4)
+		deployer.EXPECT().GetStatus(gomock.Any(), name).Return(service, nil).Times(2)
+		deployer.EXPECT().Update(gomock.Any(), data, name, image, bindings, options).Return(nil)
+		deployer.EXPECT().Ready(gomock.Any(), name).Return(false, fmt.Errorf("container exited"))
+		deployer.EXPECT().Logs(gomock.Any(), name, gomock.Any(), gomock.Any()).Return(nil)
+		if err := Up(ctx); err == nil {
+			t.Fatal("should get error when service is unhealthy")
+		}
+	})
 }
@@ -3,11 +3,31 @@ package handlers
 import (
 	"github.com/metrue/fx/config"
 	"github.com/metrue/fx/context"
+	"github.com/urfave/cli"
 )
 
 // UseInfra use infra
 func UseInfra(ctx context.Contexter) error {
 	fxConfig := ctx.Get("config").(*config.Config)
 	cli := ctx.GetCliContext()
-	return fxConfig.Use(cli.Args().First())
+	name := cli.Args().First()
+	if err := fxConfig.Use(name); err != nil {
+		return err
+	}
+	return setK8SOptions(fxConfig, name, cli)
+}
+
+// setK8SOptions set options of k8s infra given by command line
+func setK8SOptions(fxConfig *config.Config, name string, c *cli.Context) error {
+	if namespace := c.String("namespace"); namespace != "" {
+		if err := fxConfig.SetNamespace(name, namespace); err != nil {
+			return err
+		}
+	}
+	if registry := c.String("registry"); registry != "" {
+		if err := fxConfig.SetRegistry(name, registry, c.String("registry-secret")); err != nil {
+			return err
+		}
+	}
+	return nil
 }
@@ -2,11 +2,16 @@ package docker
 
 import (
 	"context"
+	"fmt"
+	"io"
 	"strconv"
+	"strings"
+	"time"
 
 	dockerTypes "github.com/docker/docker/api/types"
 	containerruntimes "github.com/metrue/fx/container_runtimes"
 	"github.com/metrue/fx/infra"
+	"github.com/metrue/fx/pkg/gateway"
 	"github.com/metrue/fx/pkg/spinner"
 	"github.com/metrue/fx/types"
 )
@@ -14,36 +19,106 @@ import (
 // Deployer manage container
 type Deployer struct {
 	cli containerruntimes.ContainerRuntime
+	// proxied services are served through port proxies, see Update
+	proxied bool
 }
 
 // CreateClient create a docker instance
 func CreateClient(client containerruntimes.ContainerRuntime) (d *Deployer, err error) {
-	return &Deployer{cli: client}, nil
+	return CreateDeployer(client)
 }
 
-// Deploy create a Docker container from given image, and bind the constants.FxContainerExposePort to given port
-func (d *Deployer) Deploy(ctx context.Context, fn string, name string, image string, ports []types.PortBinding) (err error) {
+// Deploy create a Docker container from given image, and bind the constants.FxContainerExposePort to given port,
+// through a port proxy when the runtime supports networks
+func (d *Deployer) Deploy(ctx context.Context, fn string, name string, image string, ports []types.PortBinding, options types.DeployOptions) (err error) {
 	spinner.Start("deploying " + name)
 	defer func() {
 		spinner.Stop("deploying "+name, err)
 	}()
-	return d.cli.StartContainer(ctx, name, image, ports)
-}
+	if !d.proxied {
+		return d.cli.StartContainer(ctx, name, image, ports, options)
+	}
 
-// Update a container
-func (d *Deployer) Update(ctx context.Context, name string) error {
+	upstream := slotsOf(name)[0]
+	if err := d.cli.StartContainer(ctx, upstream, image, []types.PortBinding{}, options); err != nil {
+		return err
+	}
+	if err := d.startProxy(ctx, name, upstream, ports, options.Restart); err != nil {
+		d.stop(ctx, upstream)
+		return err
+	}
 	return nil
 }
 
-// Destroy stop and remove container
+// Destroy stop and remove containers of service
 func (d *Deployer) Destroy(ctx context.Context, name string) (err error) {
 	spinner.Start("destroying " + name)
 	defer func() {
 		spinner.Stop("destroying "+name, err)
 	}()
+	var container dockerTypes.ContainerJSON
+	if err := d.cli.InspectContainer(ctx, name, &container); err == nil && isProxy(container) {
+		for _, slot := range slotsOf(name) {
+			var info dockerTypes.ContainerJSON
+			if err := d.cli.InspectContainer(ctx, slot, &info); err != nil || info.ContainerJSONBase == nil {
+				continue
+			}
+			if err := d.cli.StopContainer(ctx, slot); err != nil {
+				return err
+			}
+		}
+	}
 	return d.cli.StopContainer(ctx, name)
 }
 
+// Resources containers of a service, or of all services when name is empty
+func (d *Deployer) Resources(ctx context.Context, name string) ([]types.Resource, error) {
+	services, err := d.cli.ListContainer(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+	resources := []types.Resource{}
+	for _, s := range services {
+		container := strings.TrimPrefix(s.Name, "/")
+		// gateway is not a service, it's stopped by 'fx gateway stop'
+		if container == "" || container == gateway.ContainerName {
+			continue
+		}
+		if name != "" && container != name {
+			continue
+		}
+		resources = append(resources, types.Resource{Kind: "container", Name: container, Service: container})
+		// slot containers are not listed since they bind no host port
+		for _, slot := range slotsOf(container) {
+			var info dockerTypes.ContainerJSON
+			if err := d.cli.InspectContainer(ctx, slot, &info); err == nil && info.ContainerJSONBase != nil {
+				resources = append(resources, types.Resource{Kind: "container", Name: slot, Service: container})
+			}
+		}
+	}
+	return resources, nil
+}
+
+// Ready check if the containers of service are ready, error returned when one of them exited or it's unhealthy
+func (d *Deployer) Ready(ctx context.Context, name string) (bool, error) {
+	var container dockerTypes.ContainerJSON
+	if err := d.cli.InspectContainer(ctx, name, &container); err != nil {
+		return false, err
+	}
+	if container.ContainerJSONBase == nil {
+		return false, nil
+	}
+	if ready, err := readiness(name, container.State); err != nil || !ready || !isProxy(container) {
+		return ready, err
+	}
+
+	upstream, ok := d.upstreamOf(ctx, name)
+	if !ok {
+		return false, fmt.Errorf("no container is serving %s behind its port proxy", name)
+	}
+	return readiness(strings.TrimPrefix(upstream.Name, "/"), upstream.State)
+}
+
 // GetStatus get a service status
 func (d *Deployer) GetStatus(ctx context.Context, name string) (types.Service, error) {
 	var container dockerTypes.ContainerJSON
@@ -55,6 +130,9 @@ func (d *Deployer) GetStatus(ctx context.Context, name string) (types.Service, e
 		ID:   container.ID,
 		Name: container.Name,
 	}
+	if created, err := time.Parse(time.RFC3339Nano, container.Created); err == nil {
+		service.Created = created
+	}
 	for _, bindings := range container.NetworkSettings.Ports {
 		if len(bindings) > 0 {
 			binding := bindings[0]
@@ -73,6 +151,15 @@ func (d *Deployer) GetStatus(ctx context.Context, name string) (types.Service, e
 		}
 	}
 
+	// image and state of a proxied service are the ones of the container serving it
+	if isProxy(container) {
+		service.Image = ""
+		service.State = "exited"
+		if upstream, ok := d.upstreamOf(ctx, name); ok {
+			service.Image = upstream.Image
+			service.State = upstream.State.Status
+		}
+	}
 	return service, nil
 }
 
@@ -93,7 +180,40 @@ func (d *Deployer) List(ctx context.Context, name string) (svcs []types.Service,
 	}()
 
 	// FIXME support remote host
-	return d.cli.ListContainer(ctx, name)
+	services, err := d.cli.ListContainer(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	svcs = []types.Service{}
+	for _, s := range services {
+		name := strings.TrimPrefix(s.Name, "/")
+		// gateway is not a service
+		if name == gateway.ContainerName {
+			continue
+		}
+		var container dockerTypes.ContainerJSON
+		if err := d.cli.InspectContainer(ctx, name, &container); err == nil && isProxy(container) {
+			s.Image = ""
+			s.State = "exited"
+			if upstream, ok := d.upstreamOf(ctx, name); ok && upstream.Config != nil {
+				s.Image = upstream.Config.Image
+				s.State = upstream.State.Status
+			}
+		}
+		svcs = append(svcs, s)
+	}
+	return svcs, nil
+}
+
+// Logs write logs of a service into w, they're the logs of the container serving it when it's proxied
+func (d *Deployer) Logs(ctx context.Context, name string, options types.LogOptions, w io.Writer) error {
+	var container dockerTypes.ContainerJSON
+	if err := d.cli.InspectContainer(ctx, name, &container); err == nil && isProxy(container) {
+		if upstream, ok := d.upstreamOf(ctx, name); ok {
+			name = strings.TrimPrefix(upstream.Name, "/")
+		}
+	}
+	return d.cli.StreamContainerLogs(ctx, name, options, w)
 }
 
 var (
@@ -1,43 +1,397 @@
 package docker
 
 import (
+	"archive/tar"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
 	"testing"
+	"time"
+
+	dockerTypes "github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/go-connections/nat"
+	"github.com/metrue/fx/pkg/gateway"
+	"github.com/metrue/fx/types"
 )
 
-func TestDocker(t *testing.T) {
-	// 	ctx := context.Background()
-	// 	cli, err := CreateClient(ctx)
-	// 	if err != nil {
-	// 		t.Fatal(err)
-	// 	}
-	//
-	// 	name := "helloworld"
-	// 	bindings := []types.PortBinding{
-	// 		types.PortBinding{
-	// 			ServiceBindingPort:  80,
-	// 			ContainerExposePort: 3000,
-	// 		},
-	// 		types.PortBinding{
-	// 			ServiceBindingPort:  443,
-	// 			ContainerExposePort: 3000,
-	// 		},
-	// 	}
-	//
-	// 	fn := types.Func{
-	// 		Language: "node",
-	// 		Source: `
-	// module.exports = (ctx) => {
-	// 	ctx.body = 'hello world'
-	// }
-	// `,
-	// 	}
-	// 	if err := cli.Deploy(ctx, fn, name, name, bindings); err != nil {
-	// 		t.Fatal(err)
-	// 	}
-	//
-	// 	time.Sleep(1 * time.Second)
-	//
-	// 	if err := cli.Destroy(ctx, name); err != nil {
-	// 		t.Fatal(err)
-	// 	}
+// fakeRuntime a container runtime keeping containers in memory,
+// images in broken are started as exited containers, route tables copied into port proxies are kept in routes
+type fakeRuntime struct {
+	containers map[string]dockerTypes.ContainerJSON
+	broken     map[string]bool
+	routes     map[string]gateway.Routes
+}
+
+func newFakeRuntime() *fakeRuntime {
+	return &fakeRuntime{
+		containers: map[string]dockerTypes.ContainerJSON{},
+		broken:     map[string]bool{},
+		routes:     map[string]gateway.Routes{},
+	}
+}
+
+func (f *fakeRuntime) CreateNetwork(name string) error {
+	return nil
+}
+
+func (f *fakeRuntime) BuildImage(ctx context.Context, workdir string, name string, buildArgs map[string]string) error {
+	return nil
+}
+
+func (f *fakeRuntime) PushImage(ctx context.Context, name string, registryAuth string) (string, error) {
+	return "", nil
+}
+
+func (f *fakeRuntime) InspectImage(ctx context.Context, name string, img interface{}) error {
+	return nil
+}
+
+func (f *fakeRuntime) TagImage(ctx context.Context, name string, tag string) error {
+	return nil
+}
+
+func (f *fakeRuntime) RemoveImage(ctx context.Context, name string) error {
+	return nil
+}
+
+func (f *fakeRuntime) StartContainer(ctx context.Context, name string, image string, bindings []types.PortBinding, options types.DeployOptions) error {
+	if _, ok := f.containers[name]; ok {
+		return fmt.Errorf("container name %s is already in use", name)
+	}
+	portMap := nat.PortMap{}
+	for _, binding := range bindings {
+		port := nat.Port(fmt.Sprintf("%d/tcp", binding.ContainerExposePort))
+		portMap[port] = append(portMap[port], nat.PortBinding{
+			HostIP:   types.DefaultHost,
+			HostPort: fmt.Sprintf("%d", binding.ServiceBindingPort),
+		})
+	}
+	state := &dockerTypes.ContainerState{Status: "running", Running: true}
+	if f.broken[image] {
+		state = &dockerTypes.ContainerState{Status: "exited", ExitCode: 1}
+	}
+	f.containers[name] = dockerTypes.ContainerJSON{
+		ContainerJSONBase: &dockerTypes.ContainerJSONBase{
+			Name:       "/" + name,
+			Image:      image,
+			Created:    time.Now().Format(time.RFC3339Nano),
+			State:      state,
+			HostConfig: &container.HostConfig{PortBindings: portMap},
+		},
+		Config: &container.Config{Image: image, Env: options.EnvList()},
+		NetworkSettings: &dockerTypes.NetworkSettings{
+			NetworkSettingsBase: dockerTypes.NetworkSettingsBase{Ports: portMap},
+		},
+	}
+	return nil
+}
+
+func (f *fakeRuntime) StopContainer(ctx context.Context, name string) error {
+	if _, ok := f.containers[name]; !ok {
+		return fmt.Errorf("no such container %s", name)
+	}
+	delete(f.containers, name)
+	return nil
+}
+
+func (f *fakeRuntime) InspectContainer(ctx context.Context, name string, c interface{}) error {
+	info, ok := f.containers[name]
+	if !ok {
+		return fmt.Errorf("no such container %s", name)
+	}
+	*(c.(*dockerTypes.ContainerJSON)) = info
+	return nil
+}
+
+func (f *fakeRuntime) ListContainer(ctx context.Context, filter string) ([]types.Service, error) {
+	services := []types.Service{}
+	for _, c := range f.containers {
+		// containers binding no host port are not services
+		if len(c.HostConfig.PortBindings) == 0 {
+			continue
+		}
+		services = append(services, types.Service{ID: c.ID, Name: c.Name, Image: c.Image})
+	}
+	return services, nil
+}
+
+func (f *fakeRuntime) CopyToContainer(ctx context.Context, name string, path string, content io.Reader) error {
+	if _, ok := f.containers[name]; !ok {
+		return fmt.Errorf("no such container %s", name)
+	}
+	reader := tar.NewReader(content)
+	for {
+		header, err := reader.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if header.Name != gateway.RoutesFile {
+			continue
+		}
+		routes := gateway.Routes{}
+		if err := json.NewDecoder(reader).Decode(&routes); err != nil {
+			return err
+		}
+		f.routes[name] = routes
+	}
+}
+
+func (f *fakeRuntime) StreamContainerLogs(ctx context.Context, name string, options types.LogOptions, w io.Writer) error {
+	return nil
+}
+
+func (f *fakeRuntime) Version(ctx context.Context) (string, error) {
+	return "1.39", nil
+}
+
+// upstreamOf the upstream a port proxy is routed to, the one it's started with when it's not switched
+func (f *fakeRuntime) upstreamOf(name string) string {
+	if routes, ok := f.routes[name]; ok {
+		return routes[gateway.Upstream]
+	}
+	return envOf(f.containers[name])[gateway.UpstreamEnv]
+}
+
+func TestUpdate(t *testing.T) {
+	HealthCheck.Interval = 10 * time.Millisecond
+	HealthCheck.Timeout = 100 * time.Millisecond
+	SwitchDelay = 0
+
+	ctx := context.Background()
+	name := "sample-name"
+	bindings := []types.PortBinding{
+		types.PortBinding{
+			ServiceBindingPort:  20000,
+			ContainerExposePort: 3000,
+		},
+	}
+
+	t.Run("healthy", func(t *testing.T) {
+		runtime := newFakeRuntime()
+		d, _ := CreateDeployer(runtime)
+		if err := d.Deploy(ctx, "", name, "image-v1", bindings, types.DeployOptions{}); err != nil {
+			t.Fatal(err)
+		}
+		if upstream := runtime.upstreamOf(name); upstream != "http://sample-name-blue:3000" {
+			t.Fatalf("port proxy should route to blue slot but got %s", upstream)
+		}
+
+		// no bindings requested, the host port of the service is kept
+		if err := d.Update(ctx, "", name, "image-v2", []types.PortBinding{}, types.DeployOptions{}); err != nil {
+			t.Fatal(err)
+		}
+		if upstream := runtime.upstreamOf(name); upstream != "http://sample-name-green:3000" {
+			t.Fatalf("port proxy should be switched to green slot but got %s", upstream)
+		}
+		if _, ok := runtime.containers[name+"-blue"]; ok || len(runtime.containers) != 2 {
+			t.Fatalf("old version should be removed, but got %d containers", len(runtime.containers))
+		}
+		service, err := d.GetStatus(ctx, name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if service.Image != "image-v2" || service.Port != 20000 {
+			t.Fatalf("should get image-v2 on 20000 but got %s on %d", service.Image, service.Port)
+		}
+
+		// and switched back
+		if err := d.Update(ctx, "", name, "image-v3", bindings, types.DeployOptions{}); err != nil {
+			t.Fatal(err)
+		}
+		if upstream := runtime.upstreamOf(name); upstream != "http://sample-name-blue:3000" {
+			t.Fatalf("port proxy should be switched to blue slot but got %s", upstream)
+		}
+	})
+
+	t.Run("new port", func(t *testing.T) {
+		runtime := newFakeRuntime()
+		d, _ := CreateDeployer(runtime)
+		if err := d.Deploy(ctx, "", name, "image-v1", bindings, types.DeployOptions{}); err != nil {
+			t.Fatal(err)
+		}
+
+		requested := []types.PortBinding{
+			types.PortBinding{ServiceBindingPort: 20001, ContainerExposePort: 3000},
+		}
+		if err := d.Update(ctx, "", name, "image-v2", requested, types.DeployOptions{}); err != nil {
+			t.Fatal(err)
+		}
+		ports := hostPortsOf(runtime.containers[name])
+		expected := []types.PortBinding{bindings[0], requested[0]}
+		if !samePorts(ports, expected) {
+			t.Fatalf("should bind %v but got %v", expected, ports)
+		}
+		if upstream := runtime.upstreamOf(name); upstream != "http://sample-name-green:3000" {
+			t.Fatalf("port proxy should route to green slot but got %s", upstream)
+		}
+	})
+
+	t.Run("unhealthy", func(t *testing.T) {
+		runtime := newFakeRuntime()
+		runtime.broken["image-v2"] = true
+		d, _ := CreateDeployer(runtime)
+		if err := d.Deploy(ctx, "", name, "image-v1", bindings, types.DeployOptions{}); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := d.Update(ctx, "", name, "image-v2", bindings, types.DeployOptions{}); err == nil {
+			t.Fatal("should fail when new image is not healthy")
+		}
+		if _, ok := runtime.containers[name+"-green"]; ok || len(runtime.containers) != 2 {
+			t.Fatalf("new version should be removed, but got %d containers", len(runtime.containers))
+		}
+		if upstream := runtime.upstreamOf(name); upstream != "http://sample-name-blue:3000" {
+			t.Fatalf("port proxy should keep routing to blue slot but got %s", upstream)
+		}
+		service, err := d.GetStatus(ctx, name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if service.Image != "image-v1" || service.Port != 20000 {
+			t.Fatalf("should keep image-v1 on 20000 but got %s on %d", service.Image, service.Port)
+		}
+	})
+
+	t.Run("migrate", func(t *testing.T) {
+		runtime := newFakeRuntime()
+		d, _ := CreateDeployer(runtime)
+		// deployed before port proxies
+		if err := runtime.StartContainer(ctx, name, "image-v1", bindings, types.DeployOptions{}); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := d.Update(ctx, "", name, "image-v2", []types.PortBinding{}, types.DeployOptions{}); err != nil {
+			t.Fatal(err)
+		}
+		if !isProxy(runtime.containers[name]) {
+			t.Fatalf("%s should be served through a port proxy", name)
+		}
+		service, err := d.GetStatus(ctx, name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if service.Image != "image-v2" || service.Port != 20000 {
+			t.Fatalf("should get image-v2 on 20000 but got %s on %d", service.Image, service.Port)
+		}
+	})
+
+	t.Run("without networks", func(t *testing.T) {
+		runtime := newFakeRuntime()
+		d := &Deployer{cli: runtime}
+		if err := d.Deploy(ctx, "", name, "image-v1", bindings, types.DeployOptions{}); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := d.Update(ctx, "", name, "image-v2", []types.PortBinding{}, types.DeployOptions{}); err != nil {
+			t.Fatal(err)
+		}
+		if len(runtime.containers) != 1 {
+			t.Fatalf("temporary container should be removed, but got %d containers", len(runtime.containers))
+		}
+		service, err := d.GetStatus(ctx, name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if service.Image != "image-v2" || service.Port != 20000 {
+			t.Fatalf("should get image-v2 on 20000 but got %s on %d", service.Image, service.Port)
+		}
+	})
+}
+
+func TestResources(t *testing.T) {
+	ctx := context.Background()
+	runtime := newFakeRuntime()
+	d, _ := CreateDeployer(runtime)
+	for i, name := range []string{"hello", "world"} {
+		bindings := []types.PortBinding{
+			types.PortBinding{ServiceBindingPort: int32(20000 + i), ContainerExposePort: 3000},
+		}
+		if err := d.Deploy(ctx, "", name, "image", bindings, types.DeployOptions{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	gatewayBindings := []types.PortBinding{
+		types.PortBinding{ServiceBindingPort: 30000, ContainerExposePort: gateway.Port},
+	}
+	if err := runtime.StartContainer(ctx, gateway.ContainerName, gateway.Image, gatewayBindings, types.DeployOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	resources, err := d.Resources(ctx, "hello")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []types.Resource{
+		types.Resource{Kind: "container", Name: "hello", Service: "hello"},
+		types.Resource{Kind: "container", Name: "hello-blue", Service: "hello"},
+	}
+	if !reflect.DeepEqual(resources, expected) {
+		t.Fatalf("should get %v but got %v", expected, resources)
+	}
+
+	resources, err = d.Resources(ctx, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resources) != 4 {
+		t.Fatalf("should get containers of services only but got %v", resources)
+	}
+
+	services, err := d.List(ctx, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(services) != 2 || services[0].Image != "image" {
+		t.Fatalf("should list services with image of their functions but got %v", services)
+	}
+}
+func TestReady(t *testing.T) {
+	ctx := context.Background()
+	runtime := newFakeRuntime()
+	runtime.broken["broken"] = true
+	deployer, err := CreateDeployer(runtime)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := runtime.StartContainer(ctx, "hello", "hello", []types.PortBinding{}, types.DeployOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	ready, err := deployer.Ready(ctx, "hello")
+	if err != nil || !ready {
+		t.Fatalf("should be ready but got %v, %v", ready, err)
+	}
+
+	if err := runtime.StartContainer(ctx, "world", "broken", []types.PortBinding{}, types.DeployOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := deployer.Ready(ctx, "world"); err == nil || err.Error() != "container world is exited with exit code 1" {
+		t.Fatalf("should get exited error but got %v", err)
+	}
+
+	// a container with Docker healthcheck is ready only when it's healthy
+	container := runtime.containers["hello"]
+	container.State.Health = &dockerTypes.Health{Status: dockerTypes.Starting}
+	if ready, err := deployer.Ready(ctx, "hello"); err != nil || ready {
+		t.Fatalf("should not be ready when health check is starting but got %v, %v", ready, err)
+	}
+	container.State.Health.Status = dockerTypes.Unhealthy
+	if _, err := deployer.Ready(ctx, "hello"); err == nil {
+		t.Fatalf("should get error when container is unhealthy")
+	}
+
+	// a proxied service is ready only when the container serving it is ready
+	bindings := []types.PortBinding{types.PortBinding{ServiceBindingPort: 20000, ContainerExposePort: 3000}}
+	if err := deployer.Deploy(ctx, "", "proxied", "broken", bindings, types.DeployOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := deployer.Ready(ctx, "proxied"); err == nil || err.Error() != "container proxied-blue is exited with exit code 1" {
+		t.Fatalf("should get exited error but got %v", err)
+	}
 }
@@ -9,5 +9,6 @@ func CreateProvisioner(ip string, user string) *Provisioner {
 
 // CreateDeployer create a deployer
 func CreateDeployer(client containerruntimes.ContainerRuntime) (*Deployer, error) {
-	return &Deployer{cli: client}, nil
+	_, proxied := client.(networker)
+	return &Deployer{cli: client, proxied: proxied}, nil
 }
@@ -17,6 +17,10 @@ import (
 type Provisioner struct {
 	IP   string
 	User string
+
+	// Agent start the legacy fx-agent, which exposes docker engine on AgentPort without authentication,
+	// docker engine is reached by its unix socket, through SSH for a remote host, when it's not set
+	Agent bool
 }
 
 // NewProvisioner new a docker object
@@ -35,37 +39,16 @@ func (d *Provisioner) Provision() (config []byte, err error) {
 	}()
 
 	// TODO clean up, skip check localhost or not if in CICD env
-	if os.Getenv("CICD") != "" {
-		if err := d.Install(); err != nil {
-			return nil, err
-		}
-		if err := d.StartDockerd(); err != nil {
-			return nil, err
-		}
-		if err := d.StartFxAgent(); err != nil {
-			return nil, err
-		}
-		config, _ := json.Marshal(map[string]string{
-			"ip":   d.IP,
-			"user": d.User,
-		})
-		return config, nil
-	}
-
-	if d.isLocalHost() {
+	if d.IsLocalHost() && os.Getenv("CICD") == "" {
 		if !d.hasDocker() {
 			return nil, fmt.Errorf("please make sure docker installed and running")
 		}
-
-		if err := d.StartFxAgentLocally(); err != nil {
-			return nil, err
+		if d.Agent {
+			if err := d.StartFxAgentLocally(); err != nil {
+				return nil, err
+			}
 		}
-
-		config, _ := json.Marshal(map[string]string{
-			"ip":   d.IP,
-			"user": d.User,
-		})
-		return config, nil
+		return d.config()
 	}
 
 	if err := d.Install(); err != nil {
@@ -74,16 +57,27 @@ func (d *Provisioner) Provision() (config []byte, err error) {
 	if err := d.StartDockerd(); err != nil {
 		return nil, err
 	}
-	if err := d.StartFxAgent(); err != nil {
-		return nil, err
+	if d.Agent {
+		if err := d.StartFxAgent(); err != nil {
+			return nil, err
+		}
 	}
-	return json.Marshal(map[string]string{
+	return d.config()
+}
+
+func (d *Provisioner) config() ([]byte, error) {
+	config := map[string]string{
 		"ip":   d.IP,
 		"user": d.User,
-	})
+	}
+	if d.Agent {
+		config["agent"] = "true"
+	}
+	return json.Marshal(config)
 }
 
-func (d *Provisioner) isLocalHost() bool {
+// IsLocalHost check if host is local
+func (d *Provisioner) IsLocalHost() bool {
 	return strings.ToLower(d.IP) == "localhost" || d.IP == "127.0.0.1"
 }
 
@@ -95,12 +89,18 @@ func (d *Provisioner) hasDocker() bool {
 	return true
 }
 
-// HealthCheck check healthy status of host
+// HealthCheck check healthy status of host, fx-agent is checked only when Agent is set
 func (d *Provisioner) HealthCheck() (bool, error) {
-	if d.isLocalHost() {
-		return d.IfFxAgentRunningLocally(), nil
+	if d.IsLocalHost() {
+		if d.Agent {
+			return d.IfFxAgentRunningLocally(), nil
+		}
+		return d.hasDocker(), nil
 	}
-	return d.IfFxAgentRunning(), nil
+	if d.Agent {
+		return d.IfFxAgentRunning(), nil
+	}
+	return d.IfDockerdRunning(), nil
 }
 
 // Install docker on host
@@ -151,7 +151,7 @@ func (d *Provisioner) StartDockerd() error {
 	return nil
 }
 
-// StartFxAgent start fx agent
+// StartFxAgent start fx agent, it's legacy, anyone could reach AgentPort of host has root access of host
 func (d *Provisioner) StartFxAgent() error {
 	startCmd := fmt.Sprintf("sleep 3 && docker stop %s || true && docker run -d --name=%s --rm -v /var/run/docker.sock:/var/run/docker.sock -p 0.0.0.0:%s:1234 bobrik/socat TCP-LISTEN:1234,fork UNIX-CONNECT:/var/run/docker.sock", constants.AgentContainerName, constants.AgentContainerName, constants.AgentPort)
 	sshKeyFile, _ := infra.GetSSHKeyFile()
@@ -214,4 +214,16 @@ func (d *Provisioner) IfFxAgentRunning() bool {
 	return true
 }
 
+// IfDockerdRunning check if dockerd is running on host
+func (d *Provisioner) IfDockerdRunning() bool {
+	versionCmd := infra.Sudo("docker version", d.User)
+	sshKeyFile, _ := infra.GetSSHKeyFile()
+	sshPort := infra.GetSSHPort()
+	ssh := sshOperator.New(d.IP).WithUser(d.User).WithKey(sshKeyFile).WithPort(sshPort)
+	if err := ssh.RunCommand(versionCmd, sshOperator.CommandOptions{}); err != nil {
+		return false
+	}
+	return true
+}
+
 var _ infra.Provisioner = &Provisioner{}
@@ -0,0 +1,81 @@
+package docker
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	dockerTypes "github.com/docker/docker/api/types"
+	"github.com/metrue/fx/pkg/gateway"
+	"github.com/metrue/fx/types"
+)
+
+// A service on Docker is served through a port proxy: the container named after the service binds
+// the host ports and proxies every request to one of the slot containers of the service on fx-net,
+// <name>-blue or <name>-green. The host ports stay bound to the proxy all the time, so an update starts
+// the new version in the other slot and switches the proxy to it before the old version is stopped.
+
+// SwitchDelay time for a port proxy to pick up its new route, it polls its route table every second
+var SwitchDelay = 2 * time.Second
+
+// networker a container runtime with networks, containers of it could reach each other by name,
+// it's required by port proxies
+type networker interface {
+	CreateNetwork(name string) error
+}
+
+// slotsOf names of containers the function of a service is running in
+func slotsOf(name string) []string {
+	return []string{name + "-blue", name + "-green"}
+}
+
+// isProxy check if a container is the port proxy of a service
+func isProxy(container dockerTypes.ContainerJSON) bool {
+	return container.ContainerJSONBase != nil && envOf(container)[gateway.UpstreamEnv] != ""
+}
+
+// startProxy start port proxy of a service on ports, routing to upstream container
+func (d *Deployer) startProxy(ctx context.Context, name string, upstream string, ports []types.PortBinding, restart string) error {
+	return d.cli.StartContainer(ctx, name, gateway.Image, ports, types.DeployOptions{
+		Env:     map[string]string{gateway.UpstreamEnv: gateway.URLOf(upstream)},
+		Restart: restart,
+	})
+}
+
+// switchProxy route port proxy of a service to upstream container, and wait for the proxy to pick up the route
+func (d *Deployer) switchProxy(ctx context.Context, name string, upstream string) error {
+	archive, err := gateway.Archive(gateway.Routes{gateway.Upstream: gateway.URLOf(upstream)})
+	if err != nil {
+		return err
+	}
+	if err := d.cli.CopyToContainer(ctx, name, gateway.RoutesDir, archive); err != nil {
+		return err
+	}
+	time.Sleep(SwitchDelay)
+
+	var proxy dockerTypes.ContainerJSON
+	if err := d.cli.InspectContainer(ctx, name, &proxy); err != nil {
+		return err
+	}
+	if ready, err := readiness(name, proxy.State); err != nil || !ready {
+		return fmt.Errorf("port proxy %s is not running after switched to %s: %v", name, upstream, err)
+	}
+	return nil
+}
+
+// upstreamOf the slot container a proxied service is served by, the newer one when there are both of them
+// left by an interrupted update, ok is false when there is not any
+func (d *Deployer) upstreamOf(ctx context.Context, name string) (upstream dockerTypes.ContainerJSON, ok bool) {
+	var newest time.Time
+	for _, slot := range slotsOf(name) {
+		var container dockerTypes.ContainerJSON
+		if err := d.cli.InspectContainer(ctx, slot, &container); err != nil || container.ContainerJSONBase == nil {
+			continue
+		}
+		created, _ := time.Parse(time.RFC3339Nano, container.Created)
+		if !ok || created.After(newest) {
+			upstream, newest, ok = container, created, true
+		}
+	}
+	return upstream, ok
+}
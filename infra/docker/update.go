@@ -0,0 +1,332 @@
+package docker
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/apex/log"
+	dockerTypes "github.com/docker/docker/api/types"
+	"github.com/google/uuid"
+	"github.com/metrue/fx/constants"
+	dockerOptions "github.com/metrue/fx/container_runtimes/docker/options"
+	"github.com/metrue/fx/pkg/spinner"
+	"github.com/metrue/fx/types"
+	"github.com/phayes/freeport"
+	"github.com/pkg/errors"
+)
+
+// HealthCheck how to decide a container is healthy during update
+var HealthCheck = struct {
+	Interval time.Duration
+	Timeout  time.Duration
+	// Running how many times in a row a container without Docker healthcheck
+	// should be found running to be considered as healthy
+	Running int
+}{
+	Interval: time.Second,
+	Timeout:  30 * time.Second,
+	Running:  3,
+}
+
+// Update replace the function of a service with a container of given image without downtime,
+// host ports requested are added to the ones of the service.
+// The new image is started in the free slot of the service next to the one serving, only when it's healthy
+// the port proxy of the service is switched to it, and the old one is stopped after the switch.
+// The old one is kept serving if the new image never gets healthy or the switch fails.
+//
+// A service deployed before port proxies, with a container binding host ports itself, is migrated to a port proxy,
+// it's unavailable while its container is replaced by the proxy. So is a service on a runtime without networks,
+// e.g. Podman, since a proxy could not reach its upstream there, the container of it is replaced in place.
+// Adding host ports replaces the port proxy too, since host ports of a running container could not be changed.
+func (d *Deployer) Update(ctx context.Context, fn string, name string, image string, ports []types.PortBinding, options types.DeployOptions) (err error) {
+	spinner.Start("updating " + name)
+	defer func() {
+		spinner.Stop("updating "+name, err)
+	}()
+
+	var old dockerTypes.ContainerJSON
+	if err := d.cli.InspectContainer(ctx, name, &old); err != nil {
+		return err
+	}
+	current := hostPortsOf(old)
+	ports = mergePorts(current, ports)
+
+	if !d.proxied {
+		return d.replace(ctx, old, image, ports, options)
+	}
+	if !isProxy(old) {
+		log.Warnf("%s is migrated to be served through a port proxy, it's unavailable until the proxy is started", name)
+		return d.migrate(ctx, old, image, ports, options)
+	}
+
+	slots := slotsOf(name)
+	upstream, ok := d.upstreamOf(ctx, name)
+	if !ok {
+		return fmt.Errorf("no container is serving %s behind its port proxy", name)
+	}
+	serving := strings.TrimPrefix(upstream.Name, "/")
+	next := slots[0]
+	if serving == next {
+		next = slots[1]
+	}
+	// leftover of an interrupted update
+	var leftover dockerTypes.ContainerJSON
+	if err := d.cli.InspectContainer(ctx, next, &leftover); err == nil && leftover.ContainerJSONBase != nil {
+		if err := d.cli.StopContainer(ctx, next); err != nil {
+			return err
+		}
+	}
+
+	if err := d.cli.StartContainer(ctx, next, image, []types.PortBinding{}, options); err != nil {
+		return err
+	}
+	if err := d.waitHealthy(ctx, next); err != nil {
+		d.stop(ctx, next)
+		return errors.Wrapf(err, "new version of %s is not healthy, keep the running one", name)
+	}
+
+	if samePorts(current, ports) {
+		if err := d.switchProxy(ctx, name, next); err != nil {
+			// the route table could be copied already
+			if switchErr := d.switchProxy(ctx, name, serving); switchErr != nil {
+				log.Warnf("could not switch %s back to %s: %v", name, serving, switchErr)
+			}
+			d.stop(ctx, next)
+			return errors.Wrapf(err, "could not switch %s to new version, keep the running one", name)
+		}
+	} else {
+		log.Warnf("port proxy of %s is replaced to bind new host ports, it's unavailable until the proxy is started", name)
+		if err := d.cli.StopContainer(ctx, name); err != nil {
+			d.stop(ctx, next)
+			return err
+		}
+		if err := d.startProxy(ctx, name, next, ports, options.Restart); err != nil {
+			d.stop(ctx, next)
+			restart := dockerOptions.Of(old.Config, old.HostConfig).Restart
+			if rollbackErr := d.startProxy(ctx, name, serving, current, restart); rollbackErr != nil {
+				return errors.Wrapf(err, "update %s failed, and roll back failed too: %v", name, rollbackErr)
+			}
+			return errors.Wrapf(err, "update %s failed, rolled back", name)
+		}
+	}
+
+	return d.cli.StopContainer(ctx, serving)
+}
+
+// migrate replace the container of a service binding host ports itself with a port proxy and a slot container
+func (d *Deployer) migrate(ctx context.Context, old dockerTypes.ContainerJSON, image string, ports []types.PortBinding, options types.DeployOptions) error {
+	name := strings.TrimPrefix(old.Name, "/")
+	next := slotsOf(name)[0]
+	if err := d.cli.StartContainer(ctx, next, image, []types.PortBinding{}, options); err != nil {
+		return err
+	}
+	if err := d.waitHealthy(ctx, next); err != nil {
+		d.stop(ctx, next)
+		return errors.Wrapf(err, "new version of %s is not healthy, keep the running one", name)
+	}
+
+	if err := d.cli.StopContainer(ctx, name); err != nil {
+		d.stop(ctx, next)
+		return err
+	}
+	if err := d.startProxy(ctx, name, next, ports, options.Restart); err != nil {
+		d.stop(ctx, next)
+		return d.rollback(ctx, old, hostPortsOf(old), err)
+	}
+	return nil
+}
+
+// replace the container of a service binding host ports itself with a container of given image, with the same name.
+// The new image is started next to the old container on a temporary port first,
+// only when it's healthy the old container is replaced, and it's restored if the replacement fails.
+func (d *Deployer) replace(ctx context.Context, old dockerTypes.ContainerJSON, image string, ports []types.PortBinding, options types.DeployOptions) error {
+	name := strings.TrimPrefix(old.Name, "/")
+	tmpPort, err := freeport.GetFreePort()
+	if err != nil {
+		return err
+	}
+	candidate := name + "-" + uuid.New().String()[:8]
+	if err := d.cli.StartContainer(ctx, candidate, image, []types.PortBinding{
+		types.PortBinding{
+			ServiceBindingPort:  int32(tmpPort),
+			ContainerExposePort: constants.FxContainerExposePort,
+		},
+	}, options); err != nil {
+		return err
+	}
+	defer d.stop(ctx, candidate)
+
+	if err := d.waitHealthy(ctx, candidate); err != nil {
+		return errors.Wrapf(err, "new version of %s is not healthy, keep the running one", name)
+	}
+
+	if err := d.cli.StopContainer(ctx, name); err != nil {
+		return err
+	}
+	if err := d.cli.StartContainer(ctx, name, image, ports, options); err != nil {
+		return d.rollback(ctx, old, hostPortsOf(old), err)
+	}
+	if err := d.waitHealthy(ctx, name); err != nil {
+		if stopErr := d.cli.StopContainer(ctx, name); stopErr != nil {
+			return errors.Wrapf(err, "could not stop unhealthy %s to roll back: %v", name, stopErr)
+		}
+		return d.rollback(ctx, old, hostPortsOf(old), err)
+	}
+	return nil
+}
+
+// stop a container started during update, it's only warned when it could not be removed
+func (d *Deployer) stop(ctx context.Context, name string) {
+	if err := d.cli.StopContainer(ctx, name); err != nil {
+		log.Warnf("could not remove container %s: %v", name, err)
+	}
+}
+
+// rollback start the old image with the name, ports, environment variables and limits of service again
+func (d *Deployer) rollback(ctx context.Context, old dockerTypes.ContainerJSON, ports []types.PortBinding, cause error) error {
+	name := strings.TrimPrefix(old.Name, "/")
+	options := types.DeployOptions{}
+	if old.ContainerJSONBase != nil {
+		options = dockerOptions.Of(old.Config, old.HostConfig)
+	}
+	options.Env = envOf(old)
+	if err := d.cli.StartContainer(ctx, name, old.Image, ports, options); err != nil {
+		return errors.Wrapf(cause, "update %s failed, and roll back failed too: %v", name, err)
+	}
+	return errors.Wrapf(cause, "update %s failed, rolled back", name)
+}
+
+// waitHealthy wait until a container is healthy, a container with Docker healthcheck
+// should be reported healthy by Docker, otherwise it should keep running for a while
+func (d *Deployer) waitHealthy(ctx context.Context, name string) error {
+	deadline := time.Now().Add(HealthCheck.Timeout)
+	running := 0
+	for time.Now().Before(deadline) {
+		var container dockerTypes.ContainerJSON
+		if err := d.cli.InspectContainer(ctx, name, &container); err != nil {
+			return err
+		}
+
+		ready, err := readiness(name, container.State)
+		if err != nil {
+			return err
+		}
+		if ready {
+			// a container with Docker healthcheck is ready only when it's healthy
+			if container.State.Health != nil {
+				return nil
+			}
+			running++
+			if running >= HealthCheck.Running {
+				return nil
+			}
+		}
+		time.Sleep(HealthCheck.Interval)
+	}
+	return fmt.Errorf("container %s is not healthy after %s", name, HealthCheck.Timeout)
+}
+
+// readiness of a container, it's ready when it's running and healthy if it has Docker healthcheck,
+// error returned when it exited or it's unhealthy
+func readiness(name string, state *dockerTypes.ContainerState) (bool, error) {
+	if state == nil {
+		return false, nil
+	}
+	if !state.Running && !state.Restarting && state.Status != "created" {
+		return false, fmt.Errorf("container %s is %s with exit code %d", name, state.Status, state.ExitCode)
+	}
+	if state.Health != nil {
+		switch state.Health.Status {
+		case dockerTypes.Healthy:
+			return state.Running, nil
+		case dockerTypes.Unhealthy:
+			return false, fmt.Errorf("container %s is unhealthy", name)
+		}
+		return false, nil
+	}
+	return state.Running, nil
+}
+
+// hostPortsOf the host ports bound by a container, so that they can be taken over by a new container
+func hostPortsOf(container dockerTypes.ContainerJSON) []types.PortBinding {
+	ports := []types.PortBinding{}
+	if container.ContainerJSONBase == nil || container.HostConfig == nil {
+		return ports
+	}
+	for port, bindings := range container.HostConfig.PortBindings {
+		for _, binding := range bindings {
+			hostPort, err := strconv.Atoi(binding.HostPort)
+			if err != nil {
+				continue
+			}
+			ports = append(ports, types.PortBinding{
+				ServiceBindingPort:  int32(hostPort),
+				ContainerExposePort: int32(port.Int()),
+			})
+		}
+	}
+	return ports
+}
+
+// mergePorts add requested ports to ports, a host port requested takes the place of the same host port in ports
+func mergePorts(ports []types.PortBinding, requested []types.PortBinding) []types.PortBinding {
+	merged := []types.PortBinding{}
+	for _, port := range ports {
+		if !hasHostPort(requested, port.ServiceBindingPort) {
+			merged = append(merged, port)
+		}
+	}
+	merged = append(merged, requested...)
+	sort.Slice(merged, func(i, j int) bool {
+		return merged[i].ServiceBindingPort < merged[j].ServiceBindingPort
+	})
+	return merged
+}
+
+// samePorts check if a and b bind the same host ports to the same container ports
+func samePorts(a []types.PortBinding, b []types.PortBinding) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for _, port := range a {
+		found := false
+		for _, other := range b {
+			if other == port {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+func hasHostPort(ports []types.PortBinding, hostPort int32) bool {
+	for _, port := range ports {
+		if port.ServiceBindingPort == hostPort {
+			return true
+		}
+	}
+	return false
+}
+
+// envOf the environment variables of a container
+func envOf(container dockerTypes.ContainerJSON) map[string]string {
+	env := map[string]string{}
+	if container.Config == nil {
+		return env
+	}
+	for _, e := range container.Config.Env {
+		parts := strings.SplitN(e, "=", 2)
+		if len(parts) == 2 {
+			env[parts[0]] = parts[1]
+		}
+	}
+	return env
+}
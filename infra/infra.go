@@ -2,6 +2,7 @@ package infra
 
 import (
 	"context"
+	"io"
 
 	"github.com/metrue/fx/types"
 )
@@ -14,14 +15,22 @@ type Provisioner interface {
 
 // Deployer deploy interface
 type Deployer interface {
-	Deploy(ctx context.Context, fn string, name string, image string, bindings []types.PortBinding) error
+	Deploy(ctx context.Context, fn string, name string, image string, bindings []types.PortBinding, options types.DeployOptions) error
 	Destroy(ctx context.Context, name string) error
-	Update(ctx context.Context, name string) error
+	Resources(ctx context.Context, name string) ([]types.Resource, error)
+	Update(ctx context.Context, fn string, name string, image string, bindings []types.PortBinding, options types.DeployOptions) error
 	GetStatus(ctx context.Context, name string) (types.Service, error)
+	Ready(ctx context.Context, name string) (bool, error)
 	List(ctx context.Context, name string) ([]types.Service, error)
+	Logs(ctx context.Context, name string, options types.LogOptions, w io.Writer) error
 	Ping(ctx context.Context) error
 }
 
+// Builder build image of a Docker project in infrastructure, image reference with digest is returned
+type Builder interface {
+	BuildImage(ctx context.Context, name string, workdir string, buildArgs map[string]string) (string, error)
+}
+
 // Infra infrastructure provision interface
 type Infra interface {
 	Provisioner
@@ -0,0 +1,264 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/apex/log"
+	"github.com/metrue/fx/packer"
+	batchv1 "k8s.io/api/batch/v1"
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// BuilderImage daemonless builder which builds image in cluster and pushes it to registry
+const BuilderImage = "gcr.io/kaniko-project/executor:v1.3.0"
+
+// BuildTimeout how long to wait for a build in cluster
+var BuildTimeout = 10 * time.Minute
+
+const (
+	buildContextFile = "context.tar.gz"
+	// maxBuildContextSize a ConfigMap holds 1MiB data at most
+	maxBuildContextSize = 1024 * 1024
+)
+
+// buildNameOf name of ConfigMap holding build context of a service
+func buildNameOf(name string) string {
+	return name + "-build"
+}
+
+func generateBuildJobSpec(
+	name string,
+	job string,
+	destination string,
+	registrySecret string,
+	buildArgs map[string]string,
+) *batchv1.Job {
+	args := []string{
+		"--context=tar:///workspace/" + buildContextFile,
+		"--destination=" + destination,
+		// digest of image pushed is reported as termination message of container
+		"--digest-file=/dev/termination-log",
+	}
+	keys := []string{}
+	for k := range buildArgs {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		args = append(args, "--build-arg="+k+"="+buildArgs[k])
+	}
+
+	mounts := []apiv1.VolumeMount{
+		apiv1.VolumeMount{Name: "context", MountPath: "/workspace"},
+	}
+	volumes := []apiv1.Volume{
+		apiv1.Volume{
+			Name: "context",
+			VolumeSource: apiv1.VolumeSource{
+				ConfigMap: &apiv1.ConfigMapVolumeSource{
+					LocalObjectReference: apiv1.LocalObjectReference{Name: buildNameOf(name)},
+				},
+			},
+		},
+	}
+	if registrySecret != "" {
+		mounts = append(mounts, apiv1.VolumeMount{Name: "registry", MountPath: "/kaniko/.docker"})
+		volumes = append(volumes, apiv1.Volume{
+			Name: "registry",
+			VolumeSource: apiv1.VolumeSource{
+				Secret: &apiv1.SecretVolumeSource{
+					SecretName: registrySecret,
+					Items: []apiv1.KeyToPath{
+						apiv1.KeyToPath{Key: apiv1.DockerConfigJsonKey, Path: "config.json"},
+					},
+				},
+			},
+		})
+	}
+
+	backoffLimit := int32(0)
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   job,
+			Labels: serviceLabels(name),
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit: &backoffLimit,
+			Template: apiv1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: serviceLabels(name),
+				},
+				Spec: apiv1.PodSpec{
+					RestartPolicy: apiv1.RestartPolicyNever,
+					Containers: []apiv1.Container{
+						apiv1.Container{
+							Name:         "fx-builder",
+							Image:        BuilderImage,
+							Args:         args,
+							VolumeMounts: mounts,
+						},
+					},
+					Volumes: volumes,
+				},
+			},
+		},
+	}
+}
+
+// SetRegistry set the registry images built in cluster are pushed to, e.g. registry.example.com/team,
+// registrySecret is the name of a docker config json secret to push and pull images, it's optional
+func (k *K8S) SetRegistry(registry string, registrySecret string) {
+	k.registry = strings.TrimSuffix(registry, "/")
+	k.registrySecret = registrySecret
+}
+
+// BuildImage build image of a Docker project in workdir by a Job in cluster, the image is pushed to registry,
+// and it's returned with digest, e.g. registry.example.com/team/hello@sha256:...
+func (k *K8S) BuildImage(ctx context.Context, name string, workdir string, buildArgs map[string]string) (string, error) {
+	if k.registry == "" {
+		return "", fmt.Errorf("no registry configured to push image built in cluster")
+	}
+	namespace := k.namespace
+	if err := k.EnsureNamespace(namespace); err != nil {
+		return "", err
+	}
+
+	body, err := packer.PackIntoTarGz(workdir)
+	if err != nil {
+		return "", err
+	}
+	if len(body) > maxBuildContextSize {
+		return "", fmt.Errorf("build context of %s is %d bytes, it's larger than a ConfigMap could hold", name, len(body))
+	}
+	cm := generateConfigMapSpec(buildNameOf(name), nil)
+	cm.Labels = serviceLabels(name)
+	cm.BinaryData = map[string][]byte{buildContextFile: body}
+	if _, err := k.GetConfigMap(namespace, cm.Name); err != nil {
+		_, err = k.CoreV1().ConfigMaps(namespace).Create(cm)
+		if err != nil {
+			return "", err
+		}
+	} else if _, err := k.CoreV1().ConfigMaps(namespace).Update(cm); err != nil {
+		return "", err
+	}
+	defer func() {
+		if err := k.DeleteConfigMap(namespace, cm.Name); err != nil && !errors.IsNotFound(err) {
+			log.Warnf("could not delete build context %s: %v", cm.Name, err)
+		}
+	}()
+
+	// jobs of the earlier builds are replaced
+	if err := k.deleteBuildJobs(namespace, name); err != nil {
+		return "", err
+	}
+	repository := k.registry + "/" + name
+	job := generateBuildJobSpec(
+		name,
+		fmt.Sprintf("%s-%d", buildNameOf(name), time.Now().Unix()),
+		repository+":latest",
+		k.registrySecret,
+		buildArgs,
+	)
+	if _, err := k.BatchV1().Jobs(namespace).Create(job); err != nil {
+		return "", err
+	}
+	log.Debugf("build job %s created", job.Name)
+
+	digest, err := k.waitForBuild(ctx, namespace, job.Name)
+	if err != nil {
+		return "", err
+	}
+	// job is kept when build failed, so that its logs could be checked
+	if err := k.deleteResource("Job", namespace, job.Name); err != nil && !errors.IsNotFound(err) {
+		log.Warnf("could not delete build job %s: %v", job.Name, err)
+	}
+	return repository + "@" + digest, nil
+}
+
+func (k *K8S) deleteBuildJobs(namespace string, name string) error {
+	jobs, err := k.BatchV1().Jobs(namespace).List(metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(serviceLabels(name)).String(),
+	})
+	if err != nil {
+		return err
+	}
+	for _, job := range jobs.Items {
+		if err := k.deleteResource("Job", namespace, job.Name); err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// waitForBuild wait for build job done, digest of image pushed is returned
+func (k *K8S) waitForBuild(ctx context.Context, namespace string, job string) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, BuildTimeout)
+	defer cancel()
+
+	ticker := time.NewTicker(2 * time.Second)
+	defer ticker.Stop()
+	for {
+		j, err := k.BatchV1().Jobs(namespace).Get(job, metav1.GetOptions{})
+		if err != nil {
+			return "", err
+		}
+		if j.Status.Succeeded > 0 || j.Status.Failed > 0 {
+			pod, err := k.buildPodOf(namespace, job)
+			if err != nil {
+				return "", err
+			}
+			if j.Status.Succeeded > 0 {
+				return digestOf(pod)
+			}
+			return "", fmt.Errorf("build job %s failed: %s", job, k.tailLogs(namespace, pod.Name, 20))
+		}
+
+		select {
+		case <-ctx.Done():
+			return "", fmt.Errorf("build job %s is not done in %s", job, BuildTimeout)
+		case <-ticker.C:
+		}
+	}
+}
+
+func (k *K8S) buildPodOf(namespace string, job string) (*apiv1.Pod, error) {
+	pods, err := k.CoreV1().Pods(namespace).List(metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(map[string]string{"job-name": job}).String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(pods.Items) == 0 {
+		return nil, fmt.Errorf("no pod found for build job %s", job)
+	}
+	return &pods.Items[0], nil
+}
+
+// digestOf digest of image reported by builder as its termination message
+func digestOf(pod *apiv1.Pod) (string, error) {
+	for _, status := range pod.Status.ContainerStatuses {
+		if status.State.Terminated == nil {
+			continue
+		}
+		digest := strings.TrimSpace(status.State.Terminated.Message)
+		if strings.HasPrefix(digest, "sha256:") {
+			return digest, nil
+		}
+	}
+	return "", fmt.Errorf("no image digest reported by build pod %s", pod.Name)
+}
+
+func (k *K8S) tailLogs(namespace string, pod string, lines int64) string {
+	body, err := k.CoreV1().Pods(namespace).GetLogs(pod, &apiv1.PodLogOptions{TailLines: &lines}).Do().Raw()
+	if err != nil {
+		return fmt.Sprintf("could not get logs of pod %s: %v", pod, err)
+	}
+	return strings.TrimSpace(string(body))
+}
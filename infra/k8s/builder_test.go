@@ -0,0 +1,67 @@
+package k8s
+
+import (
+	"reflect"
+	"testing"
+
+	apiv1 "k8s.io/api/core/v1"
+)
+
+func TestBuildJobSpec(t *testing.T) {
+	job := generateBuildJobSpec(
+		"hello",
+		"hello-build-1",
+		"registry.example.com/team/hello:latest",
+		"registry-credential",
+		map[string]string{"B": "2", "A": "1"},
+	)
+	if job.Labels[serviceLabelName] != "hello" {
+		t.Fatalf("job should be labeled with service but got %v", job.Labels)
+	}
+	container := job.Spec.Template.Spec.Containers[0]
+	expected := []string{
+		"--context=tar:///workspace/context.tar.gz",
+		"--destination=registry.example.com/team/hello:latest",
+		"--digest-file=/dev/termination-log",
+		"--build-arg=A=1",
+		"--build-arg=B=2",
+	}
+	if !reflect.DeepEqual(container.Args, expected) {
+		t.Fatalf("should get %v but got %v", expected, container.Args)
+	}
+	volumes := job.Spec.Template.Spec.Volumes
+	if len(volumes) != 2 ||
+		volumes[0].ConfigMap.Name != "hello-build" ||
+		volumes[1].Secret.SecretName != "registry-credential" {
+		t.Fatalf("should mount build context and registry credential but got %+v", volumes)
+	}
+	if job.Spec.Template.Spec.RestartPolicy != apiv1.RestartPolicyNever || *job.Spec.BackoffLimit != 0 {
+		t.Fatalf("build job should not be retried")
+	}
+}
+
+func TestDigestOf(t *testing.T) {
+	pod := &apiv1.Pod{
+		Status: apiv1.PodStatus{
+			ContainerStatuses: []apiv1.ContainerStatus{
+				apiv1.ContainerStatus{
+					State: apiv1.ContainerState{
+						Terminated: &apiv1.ContainerStateTerminated{Message: "sha256:abc\n"},
+					},
+				},
+			},
+		},
+	}
+	digest, err := digestOf(pod)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if digest != "sha256:abc" {
+		t.Fatalf("should get %s but got %s", "sha256:abc", digest)
+	}
+
+	pod.Status.ContainerStatuses[0].State.Terminated.Message = ""
+	if _, err := digestOf(pod); err == nil {
+		t.Fatalf("should get error when no digest reported")
+	}
+}
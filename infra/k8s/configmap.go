@@ -5,14 +5,19 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
-// CreateConfigMap create a config map with data
-func (k *K8S) CreateConfigMap(namespace string, name string, data map[string]string) (*apiv1.ConfigMap, error) {
-	cm := &apiv1.ConfigMap{
+func generateConfigMapSpec(name string, data map[string]string) *apiv1.ConfigMap {
+	return &apiv1.ConfigMap{
 		ObjectMeta: metav1.ObjectMeta{
-			Name: name,
+			Name:   name,
+			Labels: serviceLabels(name),
 		},
 		Data: data,
 	}
+}
+
+// CreateConfigMap create a config map with data
+func (k *K8S) CreateConfigMap(namespace string, name string, data map[string]string) (*apiv1.ConfigMap, error) {
+	cm := generateConfigMapSpec(name, data)
 	return k.CoreV1().ConfigMaps(namespace).Create(cm)
 }
 
@@ -23,12 +28,7 @@ func (k *K8S) DeleteConfigMap(namespace string, name string) error {
 
 // UpdateConfigMap update a config map
 func (k *K8S) UpdateConfigMap(namespace string, name string, data map[string]string) (*apiv1.ConfigMap, error) {
-	cm := &apiv1.ConfigMap{
-		ObjectMeta: metav1.ObjectMeta{
-			Name: name,
-		},
-		Data: data,
-	}
+	cm := generateConfigMapSpec(name, data)
 	return k.CoreV1().ConfigMaps(namespace).Update(cm)
 }
 
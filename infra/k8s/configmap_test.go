@@ -11,7 +11,7 @@ func TestConfigMap(t *testing.T) {
 		t.Skip("skip test since no KUBECONFIG given in environment variable")
 	}
 
-	k8s, err := Create("")
+	k8s, err := Create("", "")
 	if err != nil {
 		t.Fatal(err)
 	}
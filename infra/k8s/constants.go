@@ -6,3 +6,21 @@ var ConfigMap = struct {
 }{
 	AppMetaEnvName: "APP_META",
 }
+
+// ownerLabels labels to tell a resource is created by fx, it's the same label
+// fx puts on the Docker images it builds
+func ownerLabels() map[string]string {
+	return map[string]string{
+		"belong-to": "fx",
+	}
+}
+
+// serviceLabelName label to tell which service a resource is created for
+const serviceLabelName = "fx-service"
+
+// serviceLabels labels of the resources fx creates for a service
+func serviceLabels(name string) map[string]string {
+	labels := ownerLabels()
+	labels[serviceLabelName] = name
+	return labels
+}
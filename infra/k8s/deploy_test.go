@@ -27,7 +27,7 @@ func TestK8SDeployer(t *testing.T) {
 	if kubeconfig == "" || username == "" || password == "" {
 		t.Skip("skip test since no KUBECONFIG, DOCKER_USERNAME and DOCKER_PASSWORD given in environment variable")
 	}
-	k8s, err := Create("")
+	k8s, err := Create("", "")
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -37,7 +37,7 @@ func TestK8SDeployer(t *testing.T) {
 		t.Fatal(err)
 	}
 	ctx := context.Background()
-	if err := k8s.Deploy(ctx, data, name, name, bindings); err != nil {
+	if err := k8s.Deploy(ctx, data, name, name, bindings, types.DeployOptions{}); err != nil {
 		t.Fatal(err)
 	}
 
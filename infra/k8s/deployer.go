@@ -4,10 +4,16 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"strings"
 
+	"github.com/apex/log"
 	"github.com/metrue/fx/infra"
+	"github.com/metrue/fx/pkg/failure"
 	"github.com/metrue/fx/pkg/spinner"
 	"github.com/metrue/fx/types"
+	appsv1 "k8s.io/api/apps/v1"
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/clientcmd"
 )
@@ -15,12 +21,30 @@ import (
 // K8S client
 type K8S struct {
 	*kubernetes.Clientset
+	// namespace services are deployed into
+	namespace string
+	// registry images built in cluster are pushed to, and secret to push and pull them
+	registry       string
+	registrySecret string
+	// includeUnlabeled resources not labeled as created by fx are destroyed as well
+	includeUnlabeled bool
 }
 
-const namespace = "default"
+// DefaultNamespace namespace services are deployed into when it's not specified
+const DefaultNamespace = "default"
 
-// Create a k8s cluster client
-func Create(kubeconfig string) (*K8S, error) {
+// defaultReplicas replicas of a service when it's not specified
+const defaultReplicas = int32(3)
+
+// selectorOf labels to select pods of a service
+func selectorOf(name string) map[string]string {
+	return map[string]string{
+		"app": "fx-app-" + name,
+	}
+}
+
+// Create a k8s cluster client, services are deployed into namespace, DefaultNamespace when it's empty
+func Create(kubeconfig string, namespace string) (*K8S, error) {
 	if os.Getenv("KUBECONFIG") != "" {
 		kubeconfig = os.Getenv("KUBECONFIG")
 	}
@@ -34,7 +58,24 @@ func Create(kubeconfig string) (*K8S, error) {
 	if err != nil {
 		return nil, err
 	}
-	return &K8S{clientset}, nil
+	if namespace == "" {
+		namespace = DefaultNamespace
+	}
+	return &K8S{Clientset: clientset, namespace: namespace}, nil
+}
+
+// Server API server of the cluster in kubeconfig
+func Server(kubeconfig string) (string, error) {
+	config, err := clientcmd.BuildConfigFromFlags("", kubeconfig)
+	if err != nil {
+		return "", err
+	}
+	return config.Host, nil
+}
+
+// Namespace services are deployed into
+func (k *K8S) Namespace() string {
+	return k.namespace
 }
 
 // Deploy a image to be a service
@@ -44,22 +85,45 @@ func (k *K8S) Deploy(
 	name string,
 	image string,
 	ports []types.PortBinding,
+	options types.DeployOptions,
 ) error {
-	data := map[string]string{}
-	data[ConfigMap.AppMetaEnvName] = fn
-	if _, err := k.CreateOrUpdateConfigMap(namespace, name, data); err != nil {
+	namespace := k.namespace
+	if err := k.EnsureNamespace(namespace); err != nil {
 		return err
 	}
 
-	selector := map[string]string{
-		"app": "fx-app-" + name,
+	// image is built by init container of deployment from the source in config map when fn is given,
+	// it's the legacy build mode which requires docker.sock on nodes, otherwise image is built already
+	// by the in-cluster builder or the Docker of K3S
+	buildInInitContainer := fn != ""
+	if buildInInitContainer {
+		data := map[string]string{}
+		data[ConfigMap.AppMetaEnvName] = fn
+		if _, err := k.CreateOrUpdateConfigMap(namespace, name, data); err != nil {
+			return err
+		}
+	}
+
+	if len(options.Secrets) > 0 {
+		if _, err := k.CreateOrUpdateSecret(namespace, name, options.Secrets); err != nil {
+			return err
+		}
+	} else if err := k.DeleteSecret(namespace, name); err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+
+	selector := selectorOf(name)
+	// pods of deployment are always restarted by Kubernetes
+	if options.Restart != "" && options.Restart != "always" {
+		log.Warnf("restart policy %s is ignored, it's supported by Docker only", options.Restart)
 	}
 
-	const replicas = int32(3)
+	replicas := defaultReplicas
+	if options.Replicas > 0 {
+		replicas = options.Replicas
+	}
 	if _, err := k.GetDeployment(namespace, name); err != nil {
-		// TODO enable passing replica from fx CLI
-		if os.Getenv("K3S") != "" {
-			// NOTE Doing docker build in initial container will fail when cluster is created by K3S
+		if !buildInInitContainer {
 			if _, err := k.CreateDeployment(
 				namespace,
 				name,
@@ -67,6 +131,7 @@ func (k *K8S) Deploy(
 				ports,
 				replicas,
 				selector,
+				options,
 			); err != nil {
 				return err
 			}
@@ -77,20 +142,35 @@ func (k *K8S) Deploy(
 				ports,
 				replicas,
 				selector,
+				options,
 			); err != nil {
 				return err
 			}
 		}
 	} else {
-		if _, err := k.UpdateDeployment(
-			namespace,
-			name,
-			name,
-			ports,
-			replicas,
-			selector,
-		); err != nil {
-			return err
+		if !buildInInitContainer {
+			if _, err := k.UpdateDeployment(
+				namespace,
+				name,
+				image,
+				ports,
+				replicas,
+				selector,
+				options,
+			); err != nil {
+				return err
+			}
+		} else {
+			if _, err := k.UpdateDeploymentWithInitContainer(
+				namespace,
+				name,
+				ports,
+				replicas,
+				selector,
+				options,
+			); err != nil {
+				return err
+			}
 		}
 	}
 
@@ -125,40 +205,64 @@ func (k *K8S) Deploy(
 	return nil
 }
 
-// Update a service
-func (k *K8S) Update(ctx context.Context, name string) error {
-	return nil
+// Update a service, Kubernetes does rolling update of the deployment itself
+func (k *K8S) Update(
+	ctx context.Context,
+	fn string,
+	name string,
+	image string,
+	ports []types.PortBinding,
+	options types.DeployOptions,
+) error {
+	return k.Deploy(ctx, fn, name, image, ports, options)
 }
 
-// Destroy a service
+// Destroy a service and all the resources created for it
 func (k *K8S) Destroy(ctx context.Context, name string) error {
-	if err := k.DeleteService(namespace, name); err != nil {
+	resources, err := k.Resources(ctx, name)
+	if err != nil {
 		return err
 	}
-	if err := k.DeleteDeployment(namespace, name); err != nil {
-		return err
+	if len(resources) == 0 {
+		return failure.NotFound(fmt.Errorf("no such service %s in namespace %s", name, k.namespace))
 	}
-	return nil
+	if !k.includeUnlabeled {
+		labeled := types.Labeled(resources)
+		if len(labeled) == 0 {
+			return failure.NotFound(fmt.Errorf("no resources labeled as created by fx for service %s in namespace %s, %d unlabeled ones found, list them with --dry-run and remove them with --include-unlabeled", name, k.namespace, len(resources)))
+		}
+		if skipped := len(resources) - len(labeled); skipped > 0 {
+			log.Warnf("%d unlabeled resources of service %s are kept, list them with --dry-run and remove them with --include-unlabeled", skipped, name)
+		}
+		resources = labeled
+	}
+	return k.DeleteResources(k.namespace, resources)
+}
+
+// IncludeUnlabeled destroy resources not labeled as created by fx as well, e.g. the ones created by an earlier fx
+func (k *K8S) IncludeUnlabeled() {
+	k.includeUnlabeled = true
+}
+
+// Resources resources created for a service, or for all services when name is empty
+func (k *K8S) Resources(ctx context.Context, name string) ([]types.Resource, error) {
+	return k.ListResources(k.namespace, name)
 }
 
 // GetStatus get status of a service
 func (k *K8S) GetStatus(ctx context.Context, name string) (types.Service, error) {
+	namespace := k.namespace
 	svc, err := k.GetService(namespace, name)
 	service := types.Service{}
+	if errors.IsNotFound(err) {
+		return service, failure.NotFound(err)
+	}
 	if err != nil {
 		return service, err
 	}
 
-	service.Host = svc.Spec.ClusterIP
-	if len(svc.Spec.ExternalIPs) > 0 {
-		service.Host = svc.Spec.ExternalIPs[0]
-	}
-
-	for _, port := range svc.Spec.Ports {
-		// TODO should clearify which port (target port, node port) should use
-		service.Port = int(port.Port)
-		break
-	}
+	service.Name = svc.Name
+	service.Host, service.Port = endpointOf(svc)
 	return service, nil
 }
 
@@ -169,7 +273,60 @@ func (k *K8S) List(ctx context.Context, name string) (svcs []types.Service, err
 	defer func() {
 		spinner.Stop(task, err)
 	}()
-	return []types.Service{}, nil
+
+	namespace := k.namespace
+	deployments, err := k.ListDeployments(namespace)
+	if err != nil {
+		return nil, err
+	}
+	services, err := k.ListServices(namespace)
+	if err != nil {
+		return nil, err
+	}
+	svcs = []types.Service{}
+	for i := range deployments.Items {
+		deployment := &deployments.Items[i]
+		if !strings.HasPrefix(deployment.Name, name) {
+			continue
+		}
+		svcs = append(svcs, toService(deployment, findService(services, deployment.Name)))
+	}
+	return svcs, nil
+}
+
+func findService(services *apiv1.ServiceList, name string) *apiv1.Service {
+	for i := range services.Items {
+		if services.Items[i].Name == name {
+			return &services.Items[i]
+		}
+	}
+	return nil
+}
+
+// toService make a fx service from a deployment and its service
+func toService(deployment *appsv1.Deployment, svc *apiv1.Service) types.Service {
+	service := types.Service{
+		ID:            string(deployment.UID),
+		Name:          deployment.Name,
+		ReadyReplicas: int(deployment.Status.ReadyReplicas),
+		State:         "pending",
+		Created:       deployment.CreationTimestamp.Time,
+	}
+	if deployment.Spec.Replicas != nil {
+		service.Replicas = int(*deployment.Spec.Replicas)
+	}
+	if service.Replicas > 0 && service.ReadyReplicas == service.Replicas {
+		service.State = "ready"
+	}
+	for _, container := range deployment.Spec.Template.Spec.Containers {
+		if container.Name == containerName {
+			service.Image = container.Image
+		}
+	}
+	if svc != nil {
+		service.Host, service.Port = endpointOf(svc)
+	}
+	return service
 }
 
 // Ping health check of infra
@@ -187,4 +344,5 @@ func (k *K8S) Ping(ctx context.Context) error {
 
 var (
 	_ infra.Deployer = &K8S{}
+	_ infra.Builder  = &K8S{}
 )
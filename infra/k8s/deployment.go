@@ -2,20 +2,30 @@ package k8s
 
 import (
 	"fmt"
+	"sort"
+	"time"
 
+	"github.com/metrue/fx/constants"
 	"github.com/metrue/fx/types"
 	appsv1 "k8s.io/api/apps/v1"
 	apiv1 "k8s.io/api/core/v1"
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
+// containerName name of function container in pod
+const containerName = "fx-placeholder-container-name"
+
 func generateDeploymentSpec(
 	name string,
 	image string,
 	bindPorts []types.PortBinding,
 	replicas int32,
 	selector map[string]string,
+	options types.DeployOptions,
 ) *appsv1.Deployment {
 	ports := []apiv1.ContainerPort{}
 	for index, binding := range bindPorts {
@@ -26,14 +36,21 @@ func generateDeploymentSpec(
 	}
 
 	container := apiv1.Container{
-		Name:            "fx-placeholder-container-name",
+		Name:            containerName,
 		Image:           image,
 		Ports:           ports,
+		Env:             envVarsOf(name, options),
 		ImagePullPolicy: v1.PullIfNotPresent,
+		Resources:       resourcesOf(options),
+	}
+	if options.HealthPath != "" {
+		container.LivenessProbe = probeOf(options.HealthPath)
+		container.ReadinessProbe = probeOf(options.HealthPath)
 	}
 	return &appsv1.Deployment{
 		ObjectMeta: metav1.ObjectMeta{
-			Name: name,
+			Name:   name,
+			Labels: serviceLabels(name),
 		},
 		Spec: appsv1.DeploymentSpec{
 			Replicas: &replicas,
@@ -52,6 +69,83 @@ func generateDeploymentSpec(
 	}
 }
 
+// resourcesOf container resources, requests are the same as limits so that a service instance
+// is only scheduled to a node could afford it
+func resourcesOf(options types.DeployOptions) apiv1.ResourceRequirements {
+	resources := apiv1.ResourceList{}
+	if options.Memory > 0 {
+		resources[apiv1.ResourceMemory] = *resource.NewQuantity(options.Memory, resource.BinarySI)
+	}
+	if options.CPUs > 0 {
+		resources[apiv1.ResourceCPU] = *resource.NewMilliQuantity(int64(options.CPUs*1000), resource.DecimalSI)
+	}
+	if len(resources) == 0 {
+		return apiv1.ResourceRequirements{}
+	}
+	return apiv1.ResourceRequirements{
+		Requests: resources,
+		Limits:   resources,
+	}
+}
+
+// probeOf HTTP probe on path of container expose port
+func probeOf(path string) *apiv1.Probe {
+	return &apiv1.Probe{
+		Handler: apiv1.Handler{
+			HTTPGet: &apiv1.HTTPGetAction{
+				Path: path,
+				Port: intstr.FromInt(constants.FxContainerExposePort),
+			},
+		},
+		InitialDelaySeconds: 5,
+		PeriodSeconds:       10,
+		TimeoutSeconds:      3,
+		FailureThreshold:    3,
+	}
+}
+
+// envVarsOf container environment variables, sorted by name to keep the spec stable.
+// Secrets are referenced from the secret of service instead of putting their values into spec
+func envVarsOf(name string, options types.DeployOptions) []apiv1.EnvVar {
+	keys := []string{}
+	for k := range options.Env {
+		if _, ok := options.Secrets[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	for k := range options.Secrets {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	vars := []apiv1.EnvVar{}
+	for _, k := range keys {
+		if _, ok := options.Secrets[k]; ok {
+			vars = append(vars, apiv1.EnvVar{
+				Name: k,
+				ValueFrom: &apiv1.EnvVarSource{
+					SecretKeyRef: &apiv1.SecretKeySelector{
+						LocalObjectReference: apiv1.LocalObjectReference{Name: name},
+						Key:                  k,
+					},
+				},
+			})
+			continue
+		}
+		vars = append(vars, apiv1.EnvVar{Name: k, Value: options.Env[k]})
+	}
+	return vars
+}
+
+// withPullSecret pull image of deployment with the docker config json secret when it's given
+func withPullSecret(deployment *appsv1.Deployment, secret string) {
+	if secret == "" {
+		return
+	}
+	deployment.Spec.Template.Spec.ImagePullSecrets = []apiv1.LocalObjectReference{
+		apiv1.LocalObjectReference{Name: secret},
+	}
+}
+
 // GetDeployment get a deployment
 func (k *K8S) GetDeployment(namespace string, name string) (*appsv1.Deployment, error) {
 	return k.AppsV1().Deployments(namespace).Get(name, metav1.GetOptions{})
@@ -65,8 +159,10 @@ func (k *K8S) CreateDeployment(
 	ports []types.PortBinding,
 	replicas int32,
 	selector map[string]string,
+	options types.DeployOptions,
 ) (*appsv1.Deployment, error) {
-	deployment := generateDeploymentSpec(name, image, ports, replicas, selector)
+	deployment := generateDeploymentSpec(name, image, ports, replicas, selector, options)
+	withPullSecret(deployment, k.registrySecret)
 	return k.AppsV1().Deployments(namespace).Create(deployment)
 }
 
@@ -78,11 +174,20 @@ func (k *K8S) UpdateDeployment(
 	ports []types.PortBinding,
 	replicas int32,
 	selector map[string]string,
+	options types.DeployOptions,
 ) (*appsv1.Deployment, error) {
-	deployment := generateDeploymentSpec(name, image, ports, replicas, selector)
+	deployment := generateDeploymentSpec(name, image, ports, replicas, selector, options)
+	withPullSecret(deployment, k.registrySecret)
 	return k.AppsV1().Deployments(namespace).Update(deployment)
 }
 
+// ListDeployments list deployments created by fx
+func (k *K8S) ListDeployments(namespace string) (*appsv1.DeploymentList, error) {
+	return k.AppsV1().Deployments(namespace).List(metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(ownerLabels()).String(),
+	})
+}
+
 // DeleteDeployment delete a deployment
 func (k *K8S) DeleteDeployment(namespace string, name string) error {
 	return k.AppsV1().Deployments(namespace).Delete(name, &metav1.DeleteOptions{})
@@ -95,8 +200,27 @@ func (k *K8S) CreateDeploymentWithInitContainer(
 	ports []types.PortBinding,
 	replicas int32,
 	selector map[string]string,
+	options types.DeployOptions,
 ) (*appsv1.Deployment, error) {
-	deployment := generateDeploymentSpec(name, name, ports, replicas, selector)
+	deployment := generateDeploymentSpec(name, name, ports, replicas, selector, options)
 	updatedDeployment := injectInitContainer(name, deployment)
 	return k.AppsV1().Deployments(namespace).Create(updatedDeployment)
 }
+
+// UpdateDeploymentWithInitContainer update a deployment which will wait InitContainer to do the image build before function container start,
+// pod template is annotated with update time to make pods rebuilt from the updated config map
+func (k *K8S) UpdateDeploymentWithInitContainer(
+	namespace string,
+	name string,
+	ports []types.PortBinding,
+	replicas int32,
+	selector map[string]string,
+	options types.DeployOptions,
+) (*appsv1.Deployment, error) {
+	deployment := generateDeploymentSpec(name, name, ports, replicas, selector, options)
+	updatedDeployment := injectInitContainer(name, deployment)
+	updatedDeployment.Spec.Template.Annotations = map[string]string{
+		"fx/updated-at": time.Now().Format(time.RFC3339),
+	}
+	return k.AppsV1().Deployments(namespace).Update(updatedDeployment)
+}
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/metrue/fx/types"
+	apiv1 "k8s.io/api/core/v1"
 )
 
 func TestDeployment(t *testing.T) {
@@ -20,7 +21,7 @@ func TestDeployment(t *testing.T) {
 		t.Skip("skip test since no KUBECONFIG given in environment variable")
 	}
 
-	k8s, err := Create("")
+	k8s, err := Create("", "")
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -39,7 +40,7 @@ func TestDeployment(t *testing.T) {
 			ContainerExposePort: 3000,
 		},
 	}
-	deployment, err := k8s.CreateDeployment(namespace, name, image, bindings, replicas, selector)
+	deployment, err := k8s.CreateDeployment(namespace, name, image, bindings, replicas, selector, types.DeployOptions{})
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -59,3 +60,41 @@ func TestDeployment(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestGenerateDeploymentSpec(t *testing.T) {
+	selector := selectorOf("hello")
+	bindings := []types.PortBinding{
+		types.PortBinding{ServiceBindingPort: 80, ContainerExposePort: 3000},
+	}
+
+	t.Run("default", func(t *testing.T) {
+		deployment := generateDeploymentSpec("hello", "hello-image", bindings, 1, selector, types.DeployOptions{})
+		container := deployment.Spec.Template.Spec.Containers[0]
+		if len(container.Resources.Limits) != 0 || container.LivenessProbe != nil || container.ReadinessProbe != nil {
+			t.Fatalf("should not set resources and probes but got %+v", container)
+		}
+	})
+
+	t.Run("resources and probes", func(t *testing.T) {
+		options := types.DeployOptions{
+			Memory:     512 * 1024 * 1024,
+			CPUs:       0.5,
+			HealthPath: "/health",
+		}
+		deployment := generateDeploymentSpec("hello", "hello-image", bindings, 1, selector, options)
+		container := deployment.Spec.Template.Spec.Containers[0]
+		for _, resources := range []apiv1.ResourceList{container.Resources.Requests, container.Resources.Limits} {
+			if memory := resources[apiv1.ResourceMemory]; memory.String() != "512Mi" {
+				t.Fatalf("should get %s but got %s", "512Mi", memory.String())
+			}
+			if cpu := resources[apiv1.ResourceCPU]; cpu.String() != "500m" {
+				t.Fatalf("should get %s but got %s", "500m", cpu.String())
+			}
+		}
+		for _, probe := range []*apiv1.Probe{container.LivenessProbe, container.ReadinessProbe} {
+			if probe == nil || probe.HTTPGet.Path != "/health" || probe.HTTPGet.Port.IntValue() != 3000 {
+				t.Fatalf("should probe /health on port 3000 but got %+v", probe)
+			}
+		}
+	})
+}
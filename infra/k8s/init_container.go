@@ -8,7 +8,9 @@ import (
 
 // This is docker image provided by fx/contrib/docker_packer
 // it can build a Docker image with give Docker project source codes encoded with base64
-// check the detail fx/contrib/docker_packer/main.go
+// check the detail fx/contrib/docker_packer/main.go.
+// It's the legacy build mode, it requires docker.sock on nodes and the image is only on the node it's built,
+// the in-cluster builder is used instead when a registry is configured, see BuildImage
 const image = "metrue/fx-docker"
 
 func injectInitContainer(name string, deployment *appsv1.Deployment) *appsv1.Deployment {
@@ -5,7 +5,7 @@ func CreateProvisioner(master MasterNode, agents []AgentNode) *Provisioner {
 	return New(master, agents)
 }
 
-// CreateDeployer create a deployer
-func CreateDeployer(kubeconfig string) (*K8S, error) {
-	return Create(kubeconfig)
+// CreateDeployer create a deployer which deploys services into namespace
+func CreateDeployer(kubeconfig string, namespace string) (*K8S, error) {
+	return Create(kubeconfig, namespace)
 }
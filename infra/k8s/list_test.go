@@ -0,0 +1,66 @@
+package k8s
+
+import (
+	"testing"
+
+	"github.com/metrue/fx/types"
+	appsv1 "k8s.io/api/apps/v1"
+	apiv1 "k8s.io/api/core/v1"
+)
+
+func TestOwnerLabels(t *testing.T) {
+	bindings := []types.PortBinding{
+		types.PortBinding{
+			ServiceBindingPort:  80,
+			ContainerExposePort: 3000,
+		},
+	}
+	selector := selectorOf("hello")
+
+	deployment := generateDeploymentSpec("hello", "hello-image", bindings, 2, selector, types.DeployOptions{})
+	if deployment.Labels["belong-to"] != "fx" {
+		t.Fatalf("deployment should be labeled with belong-to=fx but got %v", deployment.Labels)
+	}
+
+	service := generateServiceSpec("team-a", "hello", "LoadBalancer", bindings, selector)
+	if service.Labels["belong-to"] != "fx" {
+		t.Fatalf("service should be labeled with belong-to=fx but got %v", service.Labels)
+	}
+	if service.Namespace != "team-a" || service.ClusterName != "" {
+		t.Fatalf("should get namespace %s but got %s (cluster name %s)", "team-a", service.Namespace, service.ClusterName)
+	}
+}
+
+func TestToService(t *testing.T) {
+	bindings := []types.PortBinding{
+		types.PortBinding{
+			ServiceBindingPort:  80,
+			ContainerExposePort: 3000,
+		},
+	}
+	selector := selectorOf("hello")
+	deployment := generateDeploymentSpec("hello", "hello-image", bindings, 2, selector, types.DeployOptions{})
+	deployment.Status = appsv1.DeploymentStatus{ReadyReplicas: 1}
+
+	service := toService(deployment, nil)
+	if service.Name != "hello" || service.Image != "hello-image" {
+		t.Fatalf("should get hello and hello-image but got %s and %s", service.Name, service.Image)
+	}
+	if service.Replicas != 2 || service.ReadyReplicas != 1 || service.State != "pending" {
+		t.Fatalf("should get 1/2 pending but got %d/%d %s", service.ReadyReplicas, service.Replicas, service.State)
+	}
+
+	svc := generateServiceSpec(DefaultNamespace, "hello", "LoadBalancer", bindings, selector)
+	svc.Spec.ClusterIP = "10.0.0.1"
+	svc.Status.LoadBalancer.Ingress = []apiv1.LoadBalancerIngress{
+		apiv1.LoadBalancerIngress{IP: "1.2.3.4"},
+	}
+	deployment.Status.ReadyReplicas = 2
+	service = toService(deployment, svc)
+	if service.Host != "1.2.3.4" || service.Port != 80 {
+		t.Fatalf("should get 1.2.3.4:80 but got %s:%d", service.Host, service.Port)
+	}
+	if service.State != "ready" {
+		t.Fatalf("should get ready but got %s", service.State)
+	}
+}
@@ -0,0 +1,100 @@
+package k8s
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/metrue/fx/types"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// Logs write logs of all the pods of a service into w, each line is prefixed with its pod name
+func (k *K8S) Logs(ctx context.Context, name string, options types.LogOptions, w io.Writer) error {
+	namespace := k.namespace
+	pods, err := k.CoreV1().Pods(namespace).List(metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(selectorOf(name)).String(),
+	})
+	if err != nil {
+		return err
+	}
+	if len(pods.Items) == 0 {
+		return fmt.Errorf("no pod found for service %s", name)
+	}
+
+	logOptions, err := podLogOptions(options)
+	if err != nil {
+		return err
+	}
+
+	var mux sync.Mutex
+	var wg sync.WaitGroup
+	errC := make(chan error, len(pods.Items))
+	for _, pod := range pods.Items {
+		wg.Add(1)
+		go func(pod string) {
+			defer wg.Done()
+			stream, err := k.CoreV1().Pods(namespace).GetLogs(pod, logOptions).Stream()
+			if err != nil {
+				errC <- err
+				return
+			}
+			defer stream.Close()
+			if err := prefixLines(fmt.Sprintf("[%s] ", pod), stream, w, &mux); err != nil {
+				errC <- err
+			}
+		}(pod.Name)
+	}
+	wg.Wait()
+	close(errC)
+
+	return <-errC
+}
+
+func podLogOptions(options types.LogOptions) (*v1.PodLogOptions, error) {
+	logOptions := &v1.PodLogOptions{
+		Container: containerName,
+		Follow:    options.Follow,
+	}
+	if options.Since != "" {
+		if d, err := time.ParseDuration(options.Since); err == nil {
+			seconds := int64(d.Seconds())
+			logOptions.SinceSeconds = &seconds
+		} else {
+			t, err := time.Parse(time.RFC3339, options.Since)
+			if err != nil {
+				return nil, fmt.Errorf("invalid since %s, it should be a duration or a RFC3339 timestamp", options.Since)
+			}
+			since := metav1.NewTime(t)
+			logOptions.SinceTime = &since
+		}
+	}
+	if options.Tail != "" && options.Tail != "all" {
+		lines, err := strconv.ParseInt(options.Tail, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid tail %s, it should be a number or 'all'", options.Tail)
+		}
+		logOptions.TailLines = &lines
+	}
+	return logOptions, nil
+}
+
+// prefixLines copy lines from r into w with prefix, mux guards w shared by multiple pods
+func prefixLines(prefix string, r io.Reader, w io.Writer, mux *sync.Mutex) error {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		mux.Lock()
+		_, err := fmt.Fprintf(w, "%s%s\n", prefix, scanner.Text())
+		mux.Unlock()
+		if err != nil {
+			return err
+		}
+	}
+	return scanner.Err()
+}
@@ -0,0 +1,50 @@
+package k8s
+
+import (
+	"bytes"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/metrue/fx/types"
+)
+
+func TestPrefixLines(t *testing.T) {
+	var buf bytes.Buffer
+	var mux sync.Mutex
+	if err := prefixLines("[pod-1] ", strings.NewReader("hello\nworld\n"), &buf, &mux); err != nil {
+		t.Fatal(err)
+	}
+	expect := "[pod-1] hello\n[pod-1] world\n"
+	if buf.String() != expect {
+		t.Fatalf("should get %q but got %q", expect, buf.String())
+	}
+}
+
+func TestPodLogOptions(t *testing.T) {
+	opts, err := podLogOptions(types.LogOptions{Since: "10m", Tail: "20", Follow: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *opts.SinceSeconds != 600 {
+		t.Fatalf("should get %d but got %d", 600, *opts.SinceSeconds)
+	}
+	if *opts.TailLines != 20 {
+		t.Fatalf("should get %d but got %d", 20, *opts.TailLines)
+	}
+	if !opts.Follow {
+		t.Fatal("should follow logs")
+	}
+
+	opts, err = podLogOptions(types.LogOptions{Since: "2019-12-01T10:00:00Z", Tail: "all"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if opts.SinceTime == nil || opts.TailLines != nil {
+		t.Fatalf("should get since time and no tail lines but got %v", opts)
+	}
+
+	if _, err := podLogOptions(types.LogOptions{Since: "yesterday"}); err == nil {
+		t.Fatal("should get error with invalid since")
+	}
+}
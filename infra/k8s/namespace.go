@@ -0,0 +1,33 @@
+package k8s
+
+import (
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// GetNamespace get a namespace
+func (k *K8S) GetNamespace(name string) (*apiv1.Namespace, error) {
+	return k.CoreV1().Namespaces().Get(name, metav1.GetOptions{})
+}
+
+// EnsureNamespace create the namespace when it does not exist
+func (k *K8S) EnsureNamespace(name string) error {
+	_, err := k.GetNamespace(name)
+	if err == nil {
+		return nil
+	}
+	if !errors.IsNotFound(err) {
+		return err
+	}
+	ns := &apiv1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			Labels: ownerLabels(),
+		},
+	}
+	if _, err := k.CoreV1().Namespaces().Create(ns); err != nil && !errors.IsAlreadyExists(err) {
+		return err
+	}
+	return nil
+}
@@ -32,7 +32,7 @@ func (k *K8S) CreatePod(
 	labels map[string]string,
 ) (*v1.Pod, error) {
 	container := v1.Container{
-		Name:  "fx-placeholder-container-name",
+		Name:  containerName,
 		Image: image,
 		Ports: []v1.ContainerPort{
 			v1.ContainerPort{
@@ -0,0 +1,109 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// failureReasons reasons of a waiting container, which won't get better by waiting
+var failureReasons = map[string]bool{
+	"CrashLoopBackOff":           true,
+	"ErrImagePull":               true,
+	"ImagePullBackOff":           true,
+	"InvalidImageName":           true,
+	"CreateContainerError":       true,
+	"CreateContainerConfigError": true,
+}
+
+// podFailure error when a container of pod, init container included, is failed
+func podFailure(pod *apiv1.Pod) error {
+	statuses := append(append([]apiv1.ContainerStatus{}, pod.Status.InitContainerStatuses...), pod.Status.ContainerStatuses...)
+	for _, status := range statuses {
+		if waiting := status.State.Waiting; waiting != nil && failureReasons[waiting.Reason] {
+			return fmt.Errorf("container %s of pod %s is %s: %s", status.Name, pod.Name, waiting.Reason, waiting.Message)
+		}
+		if terminated := status.State.Terminated; terminated != nil && terminated.ExitCode != 0 {
+			return fmt.Errorf("container %s of pod %s exited with code %d: %s", status.Name, pod.Name, terminated.ExitCode, terminated.Reason)
+		}
+	}
+	if pod.Status.Phase == apiv1.PodFailed {
+		return fmt.Errorf("pod %s is failed: %s", pod.Name, pod.Status.Message)
+	}
+	return nil
+}
+
+// Ready check if all pods of service are updated and ready, and service answers HTTP through
+// the service proxy of API server, error returned when any pod is failed. Pods are ready only when
+// the readiness probe on health path of service succeeds, and the health path should answer without error too,
+// the same as a service is healthy on Docker
+func (k *K8S) Ready(ctx context.Context, name string) (bool, error) {
+	namespace := k.namespace
+	deployment, err := k.GetDeployment(namespace, name)
+	if err != nil {
+		return false, err
+	}
+	pods, err := k.CoreV1().Pods(namespace).List(metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(selectorOf(name)).String(),
+	})
+	if err != nil {
+		return false, err
+	}
+	for i := range pods.Items {
+		if err := podFailure(&pods.Items[i]); err != nil {
+			return false, err
+		}
+	}
+
+	replicas := int32(1)
+	if deployment.Spec.Replicas != nil {
+		replicas = *deployment.Spec.Replicas
+	}
+	status := deployment.Status
+	if status.ObservedGeneration < deployment.Generation ||
+		status.UpdatedReplicas < replicas ||
+		status.ReadyReplicas < replicas ||
+		status.Replicas > replicas {
+		return false, nil
+	}
+
+	svc, err := k.GetService(namespace, name)
+	if err != nil {
+		return false, err
+	}
+	_, port := endpointOf(svc)
+	path := healthPathOf(deployment)
+	var code int
+	k.CoreV1().RESTClient().Get().
+		Namespace(namespace).
+		Resource("services").
+		Name(fmt.Sprintf("http:%s:%d", name, port)).
+		SubResource("proxy").
+		Suffix(path).
+		Do().
+		StatusCode(&code)
+	return answers(code, path != ""), nil
+}
+
+// healthPathOf health path of service, it's the path of readiness probe of its container, empty when there is none
+func healthPathOf(deployment *appsv1.Deployment) string {
+	for _, container := range deployment.Spec.Template.Spec.Containers {
+		if probe := container.ReadinessProbe; probe != nil && probe.HTTPGet != nil {
+			return probe.HTTPGet.Path
+		}
+	}
+	return ""
+}
+
+// answers tell if service answers HTTP by the status code of response, any response from function means it's
+// answering, 5xx could be the API server failed to reach it, and error response is not accepted when strict
+func answers(code int, strict bool) bool {
+	if strict {
+		return code >= 200 && code < 400
+	}
+	return code > 0 && code < 500
+}
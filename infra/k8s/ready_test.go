@@ -0,0 +1,89 @@
+package k8s
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/metrue/fx/types"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestPodFailure(t *testing.T) {
+	pod := func(status apiv1.PodStatus) *apiv1.Pod {
+		return &apiv1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "hello-1"}, Status: status}
+	}
+	cases := []struct {
+		name     string
+		pod      *apiv1.Pod
+		expected string
+	}{
+		{
+			name: "running",
+			pod: pod(apiv1.PodStatus{
+				Phase: apiv1.PodRunning,
+				ContainerStatuses: []apiv1.ContainerStatus{
+					apiv1.ContainerStatus{Name: "fx", State: apiv1.ContainerState{Running: &apiv1.ContainerStateRunning{}}},
+				},
+			}),
+		},
+		{
+			name: "creating",
+			pod: pod(apiv1.PodStatus{
+				Phase: apiv1.PodPending,
+				ContainerStatuses: []apiv1.ContainerStatus{
+					apiv1.ContainerStatus{Name: "fx", State: apiv1.ContainerState{Waiting: &apiv1.ContainerStateWaiting{Reason: "ContainerCreating"}}},
+				},
+			}),
+		},
+		{
+			name: "crash loop",
+			pod: pod(apiv1.PodStatus{
+				Phase: apiv1.PodRunning,
+				ContainerStatuses: []apiv1.ContainerStatus{
+					apiv1.ContainerStatus{Name: "fx", State: apiv1.ContainerState{Waiting: &apiv1.ContainerStateWaiting{Reason: "CrashLoopBackOff"}}},
+				},
+			}),
+			expected: "container fx of pod hello-1 is CrashLoopBackOff",
+		},
+		{
+			name: "init container exited",
+			pod: pod(apiv1.PodStatus{
+				Phase: apiv1.PodPending,
+				InitContainerStatuses: []apiv1.ContainerStatus{
+					apiv1.ContainerStatus{Name: "build", State: apiv1.ContainerState{Terminated: &apiv1.ContainerStateTerminated{ExitCode: 2, Reason: "Error"}}},
+				},
+			}),
+			expected: "container build of pod hello-1 exited with code 2",
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := podFailure(c.pod)
+			if c.expected == "" && err != nil {
+				t.Fatalf("should get no error but got %v", err)
+			}
+			if c.expected != "" && (err == nil || !strings.Contains(err.Error(), c.expected)) {
+				t.Fatalf("should get %s but got %v", c.expected, err)
+			}
+		})
+	}
+}
+
+func TestHealthPathOf(t *testing.T) {
+	deployment := generateDeploymentSpec("hello", "hello:latest", nil, 1, selectorOf("hello"), types.DeployOptions{})
+	if path := healthPathOf(deployment); path != "" {
+		t.Fatalf("should get no health path but got %s", path)
+	}
+	if !answers(404, false) || answers(502, false) || answers(0, false) {
+		t.Fatalf("should accept any response from function without health path")
+	}
+
+	deployment = generateDeploymentSpec("hello", "hello:latest", nil, 1, selectorOf("hello"), types.DeployOptions{HealthPath: "/health"})
+	if path := healthPathOf(deployment); path != "/health" {
+		t.Fatalf("should get %s but got %s", "/health", path)
+	}
+	if !answers(200, true) || answers(404, true) || answers(503, true) {
+		t.Fatalf("should accept successful response of health path only")
+	}
+}
@@ -0,0 +1,249 @@
+package k8s
+
+import (
+	"github.com/metrue/fx/types"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// resourceKinds kinds of resources fx creates for a service, in the order they're deleted
+var resourceKinds = []string{
+	"Ingress",
+	"HorizontalPodAutoscaler",
+	"Service",
+	"Deployment",
+	"Job",
+	"Secret",
+	"ConfigMap",
+}
+
+// ListResources resources created for a service in namespace, or for all services when name is empty.
+// Resources are found by owner labels, resources created before they were labeled are found by name,
+// ConfigMaps with function source in them are listed as well, both of them are marked as unlabeled
+// since they could be created by someone else
+func (k *K8S) ListResources(namespace string, name string) ([]types.Resource, error) {
+	selector := ownerLabels()
+	if name != "" {
+		selector = serviceLabels(name)
+	}
+	listOptions := metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(selector).String(),
+	}
+
+	resources := []types.Resource{}
+	found := map[string]bool{}
+	add := func(kind string, meta metav1.ObjectMeta, unlabeled bool) {
+		service := meta.Labels[serviceLabelName]
+		if service == "" {
+			service = meta.Name
+		}
+		key := kind + "/" + meta.Name
+		if !found[key] {
+			found[key] = true
+			resources = append(resources, types.Resource{Kind: kind, Name: meta.Name, Service: service, Unlabeled: unlabeled})
+		}
+	}
+
+	for _, kind := range resourceKinds {
+		metas, err := k.listResources(kind, namespace, listOptions)
+		if err != nil {
+			return nil, err
+		}
+		for _, meta := range metas {
+			add(kind, meta, false)
+		}
+
+		if name != "" {
+			meta, err := k.getResource(kind, namespace, name)
+			if err != nil && !errors.IsNotFound(err) {
+				return nil, err
+			}
+			if err == nil {
+				if isCreatedByFx(meta, name) {
+					add(kind, *meta, false)
+				} else if isUnlabeled(kind, meta) {
+					add(kind, *meta, true)
+				}
+			}
+		}
+	}
+
+	cms, err := k.CoreV1().ConfigMaps(namespace).List(metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, cm := range cms.Items {
+		if _, ok := cm.Data[ConfigMap.AppMetaEnvName]; ok && (name == "" || cm.Name == name) && isUnlabeled("ConfigMap", &cm.ObjectMeta) {
+			add("ConfigMap", cm.ObjectMeta, true)
+		}
+	}
+	return resources, nil
+}
+
+// isCreatedByFx tell if a resource found by name is labeled as created by fx for service
+func isCreatedByFx(meta *metav1.ObjectMeta, name string) bool {
+	if meta.Labels["belong-to"] != "fx" {
+		return false
+	}
+	return meta.Labels[serviceLabelName] == "" || meta.Labels[serviceLabelName] == name
+}
+
+// isUnlabeled tell if a resource without labels of fx could be created by an earlier fx, which created services,
+// deployments and ConfigMaps of function source without labels, they're owned by no other resources
+func isUnlabeled(kind string, meta *metav1.ObjectMeta) bool {
+	if _, ok := meta.Labels["belong-to"]; ok || len(meta.OwnerReferences) > 0 {
+		return false
+	}
+	return kind == "Service" || kind == "Deployment" || kind == "ConfigMap"
+}
+
+// DeleteResources delete resources in namespace, resources already gone are skipped,
+// pods and replica sets owned by deployments and jobs are removed by garbage collector
+func (k *K8S) DeleteResources(namespace string, resources []types.Resource) error {
+	for _, kind := range resourceKinds {
+		for _, r := range resources {
+			if r.Kind != kind {
+				continue
+			}
+			if err := k.deleteResource(kind, namespace, r.Name); err != nil && !errors.IsNotFound(err) {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (k *K8S) listResources(kind string, namespace string, options metav1.ListOptions) ([]metav1.ObjectMeta, error) {
+	metas := []metav1.ObjectMeta{}
+	switch kind {
+	case "Ingress":
+		list, err := k.NetworkingV1beta1().Ingresses(namespace).List(options)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range list.Items {
+			metas = append(metas, item.ObjectMeta)
+		}
+	case "HorizontalPodAutoscaler":
+		list, err := k.AutoscalingV1().HorizontalPodAutoscalers(namespace).List(options)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range list.Items {
+			metas = append(metas, item.ObjectMeta)
+		}
+	case "Service":
+		list, err := k.CoreV1().Services(namespace).List(options)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range list.Items {
+			metas = append(metas, item.ObjectMeta)
+		}
+	case "Deployment":
+		list, err := k.AppsV1().Deployments(namespace).List(options)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range list.Items {
+			metas = append(metas, item.ObjectMeta)
+		}
+	case "Job":
+		list, err := k.BatchV1().Jobs(namespace).List(options)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range list.Items {
+			metas = append(metas, item.ObjectMeta)
+		}
+	case "Secret":
+		list, err := k.CoreV1().Secrets(namespace).List(options)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range list.Items {
+			metas = append(metas, item.ObjectMeta)
+		}
+	case "ConfigMap":
+		list, err := k.CoreV1().ConfigMaps(namespace).List(options)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range list.Items {
+			metas = append(metas, item.ObjectMeta)
+		}
+	}
+	return metas, nil
+}
+
+func (k *K8S) getResource(kind string, namespace string, name string) (*metav1.ObjectMeta, error) {
+	options := metav1.GetOptions{}
+	switch kind {
+	case "Ingress":
+		item, err := k.NetworkingV1beta1().Ingresses(namespace).Get(name, options)
+		if err != nil {
+			return nil, err
+		}
+		return &item.ObjectMeta, nil
+	case "HorizontalPodAutoscaler":
+		item, err := k.AutoscalingV1().HorizontalPodAutoscalers(namespace).Get(name, options)
+		if err != nil {
+			return nil, err
+		}
+		return &item.ObjectMeta, nil
+	case "Service":
+		item, err := k.CoreV1().Services(namespace).Get(name, options)
+		if err != nil {
+			return nil, err
+		}
+		return &item.ObjectMeta, nil
+	case "Deployment":
+		item, err := k.AppsV1().Deployments(namespace).Get(name, options)
+		if err != nil {
+			return nil, err
+		}
+		return &item.ObjectMeta, nil
+	case "Job":
+		item, err := k.BatchV1().Jobs(namespace).Get(name, options)
+		if err != nil {
+			return nil, err
+		}
+		return &item.ObjectMeta, nil
+	case "Secret":
+		item, err := k.CoreV1().Secrets(namespace).Get(name, options)
+		if err != nil {
+			return nil, err
+		}
+		return &item.ObjectMeta, nil
+	case "ConfigMap":
+		item, err := k.CoreV1().ConfigMaps(namespace).Get(name, options)
+		if err != nil {
+			return nil, err
+		}
+		return &item.ObjectMeta, nil
+	}
+	return nil, errors.NewNotFound(metav1.SchemeGroupVersion.WithResource(kind).GroupResource(), name)
+}
+
+func (k *K8S) deleteResource(kind string, namespace string, name string) error {
+	propagation := metav1.DeletePropagationBackground
+	options := &metav1.DeleteOptions{PropagationPolicy: &propagation}
+	switch kind {
+	case "Ingress":
+		return k.NetworkingV1beta1().Ingresses(namespace).Delete(name, options)
+	case "HorizontalPodAutoscaler":
+		return k.AutoscalingV1().HorizontalPodAutoscalers(namespace).Delete(name, options)
+	case "Service":
+		return k.CoreV1().Services(namespace).Delete(name, options)
+	case "Deployment":
+		return k.AppsV1().Deployments(namespace).Delete(name, options)
+	case "Job":
+		return k.BatchV1().Jobs(namespace).Delete(name, options)
+	case "Secret":
+		return k.CoreV1().Secrets(namespace).Delete(name, options)
+	case "ConfigMap":
+		return k.CoreV1().ConfigMaps(namespace).Delete(name, options)
+	}
+	return nil
+}
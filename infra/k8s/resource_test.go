@@ -0,0 +1,46 @@
+package k8s
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestIsCreatedByFx(t *testing.T) {
+	cases := []struct {
+		kind      string
+		labels    map[string]string
+		owners    []metav1.OwnerReference
+		expected  bool
+		unlabeled bool
+	}{
+		{"Deployment", serviceLabels("hello"), nil, true, false},
+		{"Deployment", serviceLabels("world"), nil, false, false},
+		{"Secret", ownerLabels(), nil, true, false},
+		{"Service", nil, nil, false, true},
+		{"Service", map[string]string{"belong-to": "team-a"}, nil, false, false},
+		{"Deployment", nil, []metav1.OwnerReference{{Kind: "Application", Name: "hello"}}, false, false},
+		{"Secret", nil, nil, false, false},
+		{"ConfigMap", nil, nil, false, true},
+	}
+	for _, c := range cases {
+		meta := &metav1.ObjectMeta{Name: "hello", Labels: c.labels, OwnerReferences: c.owners}
+		if got := isCreatedByFx(meta, "hello"); got != c.expected {
+			t.Fatalf("%s with labels %v should get %v but got %v", c.kind, c.labels, c.expected, got)
+		}
+		if got := isUnlabeled(c.kind, meta); got != c.unlabeled {
+			t.Fatalf("%s with labels %v should be unlabeled %v but got %v", c.kind, c.labels, c.unlabeled, got)
+		}
+	}
+}
+
+func TestServiceLabels(t *testing.T) {
+	cm := generateConfigMapSpec("hello", map[string]string{})
+	if cm.Labels["belong-to"] != "fx" || cm.Labels[serviceLabelName] != "hello" {
+		t.Fatalf("config map should be labeled with service but got %v", cm.Labels)
+	}
+	secret := generateSecretSpec("hello", map[string]string{})
+	if secret.Labels[serviceLabelName] != "hello" {
+		t.Fatalf("secret should be labeled with service but got %v", secret.Labels)
+	}
+}
@@ -0,0 +1,36 @@
+package k8s
+
+import (
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func generateSecretSpec(name string, data map[string]string) *apiv1.Secret {
+	return &apiv1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			Labels: serviceLabels(name),
+		},
+		Type:       apiv1.SecretTypeOpaque,
+		StringData: data,
+	}
+}
+
+// GetSecret get a secret
+func (k *K8S) GetSecret(namespace string, name string) (*apiv1.Secret, error) {
+	return k.CoreV1().Secrets(namespace).Get(name, metav1.GetOptions{})
+}
+
+// CreateOrUpdateSecret create or update a secret with data, keys not in data are removed on update
+func (k *K8S) CreateOrUpdateSecret(namespace string, name string, data map[string]string) (*apiv1.Secret, error) {
+	secret := generateSecretSpec(name, data)
+	if _, err := k.GetSecret(namespace, name); err != nil {
+		return k.CoreV1().Secrets(namespace).Create(secret)
+	}
+	return k.CoreV1().Secrets(namespace).Update(secret)
+}
+
+// DeleteSecret delete a secret
+func (k *K8S) DeleteSecret(namespace string, name string) error {
+	return k.CoreV1().Secrets(namespace).Delete(name, &metav1.DeleteOptions{})
+}
@@ -0,0 +1,42 @@
+package k8s
+
+import (
+	"testing"
+
+	"github.com/metrue/fx/types"
+)
+
+func TestSecretEnv(t *testing.T) {
+	options := types.DeployOptions{
+		Env: map[string]string{
+			"GREETING":    "hello",
+			"DB_PASSWORD": "not-a-secret",
+		},
+		Secrets: map[string]string{
+			"DB_PASSWORD": "s3cr3t",
+		},
+	}
+
+	vars := envVarsOf("hello", options)
+	if len(vars) != 2 {
+		t.Fatalf("should get %d env vars but got %d", 2, len(vars))
+	}
+	if vars[0].Name != "DB_PASSWORD" || vars[0].Value != "" {
+		t.Fatalf("secret should not be put into spec but got %+v", vars[0])
+	}
+	ref := vars[0].ValueFrom.SecretKeyRef
+	if ref.Name != "hello" || ref.Key != "DB_PASSWORD" {
+		t.Fatalf("should reference secret hello but got %+v", ref)
+	}
+	if vars[1].Name != "GREETING" || vars[1].Value != "hello" {
+		t.Fatalf("should get GREETING=hello but got %+v", vars[1])
+	}
+
+	secret := generateSecretSpec("hello", options.Secrets)
+	if secret.Labels["belong-to"] != "fx" {
+		t.Fatalf("secret should be labeled with belong-to=fx but got %v", secret.Labels)
+	}
+	if secret.StringData["DB_PASSWORD"] != "s3cr3t" {
+		t.Fatalf("should get %s but got %s", "s3cr3t", secret.StringData["DB_PASSWORD"])
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/metrue/fx/types"
 	apiv1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	intstr "k8s.io/apimachinery/pkg/util/intstr"
 )
 
@@ -28,8 +29,9 @@ func generateServiceSpec(
 
 	return &apiv1.Service{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:        name,
-			ClusterName: namespace,
+			Name:      name,
+			Namespace: namespace,
+			Labels:    serviceLabels(name),
 		},
 		Spec: apiv1.ServiceSpec{
 			Ports:    servicePorts,
@@ -69,6 +71,12 @@ func (k *K8S) UpdateService(
 	if err != nil {
 		return nil, err
 	}
+	if svc.Labels == nil {
+		svc.Labels = map[string]string{}
+	}
+	for k, v := range serviceLabels(name) {
+		svc.Labels[k] = v
+	}
 	svc.Spec.Selector = selector
 	svc.Spec.Type = apiv1.ServiceType(typ)
 	return k.CoreV1().Services(namespace).Update(svc)
@@ -85,3 +93,36 @@ func (k *K8S) DeleteService(namespace string, name string) error {
 func (k *K8S) GetService(namespace string, name string) (*apiv1.Service, error) {
 	return k.CoreV1().Services(namespace).Get(name, metav1.GetOptions{})
 }
+
+// ListServices list services created by fx
+func (k *K8S) ListServices(namespace string) (*apiv1.ServiceList, error) {
+	return k.CoreV1().Services(namespace).List(metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(ownerLabels()).String(),
+	})
+}
+
+// endpointOf get the endpoint of a service, external address is preferred
+func endpointOf(svc *apiv1.Service) (string, int) {
+	host := svc.Spec.ClusterIP
+	if len(svc.Spec.ExternalIPs) > 0 {
+		host = svc.Spec.ExternalIPs[0]
+	}
+	for _, ingress := range svc.Status.LoadBalancer.Ingress {
+		if ingress.IP != "" {
+			host = ingress.IP
+			break
+		}
+		if ingress.Hostname != "" {
+			host = ingress.Hostname
+			break
+		}
+	}
+
+	var port int
+	for _, p := range svc.Spec.Ports {
+		// TODO should clearify which port (target port, node port) should use
+		port = int(p.Port)
+		break
+	}
+	return host, port
+}
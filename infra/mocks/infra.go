@@ -8,6 +8,7 @@ import (
 	context "context"
 	gomock "github.com/golang/mock/gomock"
 	types "github.com/metrue/fx/types"
+	io "io"
 	reflect "reflect"
 )
 
@@ -88,17 +89,17 @@ func (m *MockDeployer) EXPECT() *MockDeployerMockRecorder {
 }
 
 // Deploy mocks base method
-func (m *MockDeployer) Deploy(ctx context.Context, fn, name, image string, bindings []types.PortBinding) error {
+func (m *MockDeployer) Deploy(ctx context.Context, fn, name, image string, bindings []types.PortBinding, options types.DeployOptions) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Deploy", ctx, fn, name, image, bindings)
+	ret := m.ctrl.Call(m, "Deploy", ctx, fn, name, image, bindings, options)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Deploy indicates an expected call of Deploy
-func (mr *MockDeployerMockRecorder) Deploy(ctx, fn, name, image, bindings interface{}) *gomock.Call {
+func (mr *MockDeployerMockRecorder) Deploy(ctx, fn, name, image, bindings, options interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Deploy", reflect.TypeOf((*MockDeployer)(nil).Deploy), ctx, fn, name, image, bindings)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Deploy", reflect.TypeOf((*MockDeployer)(nil).Deploy), ctx, fn, name, image, bindings, options)
 }
 
 // Destroy mocks base method
@@ -115,18 +116,48 @@ func (mr *MockDeployerMockRecorder) Destroy(ctx, name interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Destroy", reflect.TypeOf((*MockDeployer)(nil).Destroy), ctx, name)
 }
 
+// Resources mocks base method
+func (m *MockDeployer) Resources(ctx context.Context, name string) ([]types.Resource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Resources", ctx, name)
+	ret0, _ := ret[0].([]types.Resource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Resources indicates an expected call of Resources
+func (mr *MockDeployerMockRecorder) Resources(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resources", reflect.TypeOf((*MockDeployer)(nil).Resources), ctx, name)
+}
+
 // Update mocks base method
-func (m *MockDeployer) Update(ctx context.Context, name string) error {
+func (m *MockDeployer) Update(ctx context.Context, fn, name, image string, bindings []types.PortBinding, options types.DeployOptions) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Update", ctx, name)
+	ret := m.ctrl.Call(m, "Update", ctx, fn, name, image, bindings, options)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Update indicates an expected call of Update
-func (mr *MockDeployerMockRecorder) Update(ctx, name interface{}) *gomock.Call {
+func (mr *MockDeployerMockRecorder) Update(ctx, fn, name, image, bindings, options interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockDeployer)(nil).Update), ctx, name)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockDeployer)(nil).Update), ctx, fn, name, image, bindings, options)
+}
+
+// Ready mocks base method
+func (m *MockDeployer) Ready(ctx context.Context, name string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Ready", ctx, name)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Ready indicates an expected call of Ready
+func (mr *MockDeployerMockRecorder) Ready(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ready", reflect.TypeOf((*MockDeployer)(nil).Ready), ctx, name)
 }
 
 // GetStatus mocks base method
@@ -159,6 +190,20 @@ func (mr *MockDeployerMockRecorder) List(ctx, name interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockDeployer)(nil).List), ctx, name)
 }
 
+// Logs mocks base method
+func (m *MockDeployer) Logs(ctx context.Context, name string, options types.LogOptions, w io.Writer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Logs", ctx, name, options, w)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Logs indicates an expected call of Logs
+func (mr *MockDeployerMockRecorder) Logs(ctx, name, options, w interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Logs", reflect.TypeOf((*MockDeployer)(nil).Logs), ctx, name, options, w)
+}
+
 // Ping mocks base method
 func (m *MockDeployer) Ping(ctx context.Context) error {
 	m.ctrl.T.Helper()
@@ -227,17 +272,17 @@ func (mr *MockInfraMockRecorder) HealthCheck() *gomock.Call {
 }
 
 // Deploy mocks base method
-func (m *MockInfra) Deploy(ctx context.Context, fn, name, image string, bindings []types.PortBinding) error {
+func (m *MockInfra) Deploy(ctx context.Context, fn, name, image string, bindings []types.PortBinding, options types.DeployOptions) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Deploy", ctx, fn, name, image, bindings)
+	ret := m.ctrl.Call(m, "Deploy", ctx, fn, name, image, bindings, options)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Deploy indicates an expected call of Deploy
-func (mr *MockInfraMockRecorder) Deploy(ctx, fn, name, image, bindings interface{}) *gomock.Call {
+func (mr *MockInfraMockRecorder) Deploy(ctx, fn, name, image, bindings, options interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Deploy", reflect.TypeOf((*MockInfra)(nil).Deploy), ctx, fn, name, image, bindings)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Deploy", reflect.TypeOf((*MockInfra)(nil).Deploy), ctx, fn, name, image, bindings, options)
 }
 
 // Destroy mocks base method
@@ -254,18 +299,48 @@ func (mr *MockInfraMockRecorder) Destroy(ctx, name interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Destroy", reflect.TypeOf((*MockInfra)(nil).Destroy), ctx, name)
 }
 
+// Resources mocks base method
+func (m *MockInfra) Resources(ctx context.Context, name string) ([]types.Resource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Resources", ctx, name)
+	ret0, _ := ret[0].([]types.Resource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Resources indicates an expected call of Resources
+func (mr *MockInfraMockRecorder) Resources(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resources", reflect.TypeOf((*MockInfra)(nil).Resources), ctx, name)
+}
+
 // Update mocks base method
-func (m *MockInfra) Update(ctx context.Context, name string) error {
+func (m *MockInfra) Update(ctx context.Context, fn, name, image string, bindings []types.PortBinding, options types.DeployOptions) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Update", ctx, name)
+	ret := m.ctrl.Call(m, "Update", ctx, fn, name, image, bindings, options)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Update indicates an expected call of Update
-func (mr *MockInfraMockRecorder) Update(ctx, name interface{}) *gomock.Call {
+func (mr *MockInfraMockRecorder) Update(ctx, fn, name, image, bindings, options interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockInfra)(nil).Update), ctx, name)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockInfra)(nil).Update), ctx, fn, name, image, bindings, options)
+}
+
+// Ready mocks base method
+func (m *MockInfra) Ready(ctx context.Context, name string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Ready", ctx, name)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Ready indicates an expected call of Ready
+func (mr *MockInfraMockRecorder) Ready(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ready", reflect.TypeOf((*MockInfra)(nil).Ready), ctx, name)
 }
 
 // GetStatus mocks base method
@@ -298,6 +373,20 @@ func (mr *MockInfraMockRecorder) List(ctx, name interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockInfra)(nil).List), ctx, name)
 }
 
+// Logs mocks base method
+func (m *MockInfra) Logs(ctx context.Context, name string, options types.LogOptions, w io.Writer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Logs", ctx, name, options, w)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Logs indicates an expected call of Logs
+func (mr *MockInfraMockRecorder) Logs(ctx, name, options, w interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Logs", reflect.TypeOf((*MockInfra)(nil).Logs), ctx, name, options, w)
+}
+
 // Ping mocks base method
 func (m *MockInfra) Ping(ctx context.Context) error {
 	m.ctrl.T.Helper()
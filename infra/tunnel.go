@@ -0,0 +1,163 @@
+package infra
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"sync"
+
+	"github.com/apex/log"
+	"github.com/mitchellh/go-homedir"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+)
+
+// DockerSocket unix socket of docker engine on host
+const DockerSocket = "/var/run/docker.sock"
+
+// SSHTunnel forward connections to a unix socket on remote host through SSH,
+// the key of GetSSHKeyFile and port of GetSSHPort are used to connect host
+type SSHTunnel struct {
+	mux    sync.Mutex
+	user   string
+	host   string
+	socket string
+	client *ssh.Client
+
+	hostKeyCallback ssh.HostKeyCallback
+	ignoreHostKey   bool
+}
+
+// NewSSHTunnel new a tunnel to socket on host, DockerSocket is used when socket is empty
+func NewSSHTunnel(user string, host string, socket string) *SSHTunnel {
+	if socket == "" {
+		socket = DockerSocket
+	}
+	return &SSHTunnel{
+		user:   user,
+		host:   host,
+		socket: socket,
+	}
+}
+
+// IgnoreHostKey do not verify host key, the host could be impersonated,
+// it's only for hosts created with --insecure-ignore-host-key
+func (t *SSHTunnel) IgnoreHostKey() {
+	t.ignoreHostKey = true
+}
+
+// DialContext dial the socket on remote host, network and addr are ignored,
+// it has the signature of net.Dialer.DialContext so that it could be used by a http.Transport
+func (t *SSHTunnel) DialContext(ctx context.Context, network string, addr string) (net.Conn, error) {
+	client, err := t.connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn, err := client.Dial("unix", t.socket)
+	if err == nil {
+		return conn, nil
+	}
+
+	// SSH connection could be broken, e.g. host restarted, reconnect once
+	t.reset(client)
+	client, err = t.connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn, err = client.Dial("unix", t.socket)
+	if err != nil {
+		return nil, fmt.Errorf("could not dial %s on %s: %v", t.socket, t.host, err)
+	}
+	return conn, nil
+}
+
+// Close the SSH connection
+func (t *SSHTunnel) Close() error {
+	t.mux.Lock()
+	defer t.mux.Unlock()
+	if t.client == nil {
+		return nil
+	}
+	err := t.client.Close()
+	t.client = nil
+	return err
+}
+
+func (t *SSHTunnel) connect(ctx context.Context) (*ssh.Client, error) {
+	t.mux.Lock()
+	defer t.mux.Unlock()
+	if t.client != nil {
+		return t.client, nil
+	}
+
+	keyFile, err := GetSSHKeyFile()
+	if err != nil {
+		return nil, err
+	}
+	key, err := ioutil.ReadFile(keyFile)
+	if err != nil {
+		return nil, err
+	}
+	signer, err := ssh.ParsePrivateKey(key)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse SSH key %s: %v", keyFile, err)
+	}
+	hostKeyCallback := t.hostKeyCallback
+	if hostKeyCallback == nil {
+		if hostKeyCallback, err = knownHostsCallback(t.host, t.ignoreHostKey); err != nil {
+			return nil, err
+		}
+	}
+	config := &ssh.ClientConfig{
+		User:            t.user,
+		Auth:            []ssh.AuthMethod{ssh.PublicKeys(signer)},
+		HostKeyCallback: hostKeyCallback,
+	}
+
+	addr := net.JoinHostPort(t.host, GetSSHPort())
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+	c, chans, reqs, err := ssh.NewClientConn(conn, addr, config)
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("could not connect %s@%s: %v", t.user, addr, err)
+	}
+	t.client = ssh.NewClient(c, chans, reqs)
+	return t.client, nil
+}
+
+func (t *SSHTunnel) reset(client *ssh.Client) {
+	t.mux.Lock()
+	defer t.mux.Unlock()
+	if t.client == client {
+		t.client.Close()
+		t.client = nil
+	}
+}
+
+// knownHostsCallback verify host key with ~/.ssh/known_hosts, or the file of $SSH_KNOWN_HOSTS,
+// it fails when there is no such file, unless host key is ignored explicitly
+func knownHostsCallback(host string, ignoreHostKey bool) (ssh.HostKeyCallback, error) {
+	if ignoreHostKey {
+		log.Warnf("host key of %s is not verified, the host could be impersonated", host)
+		// nolint: gosec
+		return ssh.InsecureIgnoreHostKey(), nil
+	}
+	file := os.Getenv("SSH_KNOWN_HOSTS")
+	if file == "" {
+		f, err := homedir.Expand("~/.ssh/known_hosts")
+		if err != nil {
+			return nil, err
+		}
+		file = f
+	}
+	if _, err := os.Stat(file); os.IsNotExist(err) {
+		return nil, fmt.Errorf("could not verify host key of %s, %s not found, add the host to it with 'ssh-keyscan -p %s %s >> %s', or create the infrastructure with --insecure-ignore-host-key", host, file, GetSSHPort(), host, file)
+	}
+	return knownhosts.New(file)
+}
@@ -0,0 +1,186 @@
+package infra
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"golang.org/x/crypto/ssh"
+)
+
+// serveSSH a SSH server forwards direct-streamlocal channels to local unix sockets,
+// the same as sshd does for 'ssh -L'
+func serveSSH(listener net.Listener, config *ssh.ServerConfig) {
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		go func() {
+			_, chans, reqs, err := ssh.NewServerConn(conn, config)
+			if err != nil {
+				return
+			}
+			go ssh.DiscardRequests(reqs)
+			for ch := range chans {
+				if ch.ChannelType() != "direct-streamlocal@openssh.com" {
+					_ = ch.Reject(ssh.UnknownChannelType, "unsupported")
+					continue
+				}
+				var payload struct {
+					SocketPath string
+					Reserved0  string
+					Reserved1  uint32
+				}
+				if err := ssh.Unmarshal(ch.ExtraData(), &payload); err != nil {
+					_ = ch.Reject(ssh.ConnectionFailed, err.Error())
+					continue
+				}
+				upstream, err := net.Dial("unix", payload.SocketPath)
+				if err != nil {
+					_ = ch.Reject(ssh.ConnectionFailed, err.Error())
+					continue
+				}
+				channel, creqs, err := ch.Accept()
+				if err != nil {
+					upstream.Close()
+					continue
+				}
+				go ssh.DiscardRequests(creqs)
+				go func() {
+					defer channel.Close()
+					_, _ = io.Copy(channel, upstream)
+				}()
+				go func() {
+					defer upstream.Close()
+					_, _ = io.Copy(upstream, channel)
+				}()
+			}
+		}()
+	}
+}
+
+func TestSSHTunnel(t *testing.T) {
+	dir, err := ioutil.TempDir("", "fx-tunnel")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	// a http server on unix socket stands for docker engine
+	socket := filepath.Join(dir, "docker.sock")
+	engine, err := net.Listen("unix", socket)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer engine.Close()
+	go func() {
+		_ = http.Serve(engine, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte("pong"))
+		}))
+	}()
+
+	clientKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyFile := filepath.Join(dir, "id_rsa")
+	body := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(clientKey)})
+	if err := ioutil.WriteFile(keyFile, body, 0600); err != nil {
+		t.Fatal(err)
+	}
+	clientPublicKey, err := ssh.NewPublicKey(&clientKey.PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	hostKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	hostSigner, err := ssh.NewSignerFromKey(hostKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	config := &ssh.ServerConfig{
+		PublicKeyCallback: func(meta ssh.ConnMetadata, key ssh.PublicKey) (*ssh.Permissions, error) {
+			if meta.User() == "fx" && string(key.Marshal()) == string(clientPublicKey.Marshal()) {
+				return nil, nil
+			}
+			return nil, io.EOF
+		},
+	}
+	config.AddHostKey(hostSigner)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer listener.Close()
+	go serveSSH(listener, config)
+
+	_, port, _ := net.SplitHostPort(listener.Addr().String())
+	os.Setenv("SSH_KEY_FILE", keyFile)
+	os.Setenv("SSH_PORT", port)
+	defer os.Unsetenv("SSH_KEY_FILE")
+	defer os.Unsetenv("SSH_PORT")
+
+	t.Run("unknown host key", func(t *testing.T) {
+		tunnel := NewSSHTunnel("fx", "127.0.0.1", socket)
+		tunnel.hostKeyCallback = ssh.FixedHostKey(clientPublicKey)
+		defer tunnel.Close()
+		if _, err := tunnel.DialContext(context.Background(), "tcp", "docker:80"); err == nil {
+			t.Fatalf("should get error when host key mismatched")
+		}
+	})
+
+	t.Run("no known hosts", func(t *testing.T) {
+		os.Setenv("SSH_KNOWN_HOSTS", filepath.Join(dir, "known_hosts"))
+		defer os.Unsetenv("SSH_KNOWN_HOSTS")
+
+		tunnel := NewSSHTunnel("fx", "127.0.0.1", socket)
+		defer tunnel.Close()
+		if _, err := tunnel.DialContext(context.Background(), "tcp", "docker:80"); err == nil || !strings.Contains(err.Error(), "--insecure-ignore-host-key") {
+			t.Fatalf("should get error when there is no known hosts but got %v", err)
+		}
+
+		tunnel = NewSSHTunnel("fx", "127.0.0.1", socket)
+		tunnel.IgnoreHostKey()
+		defer tunnel.Close()
+		conn, err := tunnel.DialContext(context.Background(), "tcp", "docker:80")
+		if err != nil {
+			t.Fatal(err)
+		}
+		conn.Close()
+	})
+
+	t.Run("forward", func(t *testing.T) {
+		tunnel := NewSSHTunnel("fx", "127.0.0.1", socket)
+		tunnel.hostKeyCallback = ssh.FixedHostKey(hostSigner.PublicKey())
+		defer tunnel.Close()
+
+		client := &http.Client{Transport: &http.Transport{DialContext: tunnel.DialContext}}
+		resp, err := client.Get("http://docker/_ping")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		body, err := ioutil.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(body) != "pong" {
+			t.Fatalf("should get %s but got %s", "pong", body)
+		}
+	})
+}
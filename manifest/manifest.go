@@ -0,0 +1,438 @@
+package manifest
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/metrue/fx/constants"
+	"github.com/metrue/fx/packer"
+	"github.com/metrue/fx/types"
+	"github.com/metrue/fx/utils"
+	"gopkg.in/yaml.v3"
+)
+
+// FileName name of manifest file fx looks for next to the sources
+const FileName = "fx.yml"
+
+// Manifest declares how a function is deployed, e.g.
+//
+//	name: hello
+//	sources:
+//	  - fx.js
+//	  - helper.js
+//	language: node
+//	ports:
+//	  - 8080        # host port 8080 to container port 3000
+//	  - 8443:3000
+//	env:
+//	  GREETING: hello
+//	secrets:
+//	  - DB_PASSWORD # set with 'fx secret set'
+//	replicas: 2
+//	memory: 512m        # memory limit of each instance
+//	cpus: 0.5           # CPUs each instance could use
+//	restart: on-failure # restart policy on Docker
+//	health_path: /health
+//	infra: my-k8s
+//	build_args:
+//	  NPM_REGISTRY: https://registry.npmjs.org
+//
+// or a project of functions, each function has its own name, sources, ports and so on,
+// env, secrets, build_args, language, replicas, memory, cpus, restart and health_path on top level
+// are shared by all functions
+//
+//	infra: my-k8s
+//	env:
+//	  GREETING: hello
+//	functions:
+//	  - name: hello
+//	    sources:
+//	      - hello/fx.js
+//	    ports:
+//	      - 8080
+//	  - name: world
+//	    sources:
+//	      - world/fx.py
+type Manifest struct {
+	Name       string            `yaml:"name"`
+	Sources    []string          `yaml:"sources"`
+	Language   string            `yaml:"language"`
+	Ports      []string          `yaml:"ports"`
+	Env        map[string]string `yaml:"env"`
+	Secrets    []string          `yaml:"secrets"`
+	Replicas   int32             `yaml:"replicas"`
+	Memory     string            `yaml:"memory"`
+	CPUs       string            `yaml:"cpus"`
+	Restart    string            `yaml:"restart"`
+	HealthPath string            `yaml:"health_path"`
+	Infra      string            `yaml:"infra"`
+	BuildArgs  map[string]string `yaml:"build_args"`
+	Functions  []*Manifest       `yaml:"functions"`
+
+	// dir is where manifest file is, sources are relative to it
+	dir string
+}
+
+var (
+	namePattern = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.-]*$`)
+	keyPattern  = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+)
+
+// Find the manifest file next to sources, it's in the directory when the first source is a directory,
+// or in the directory of the first source file, or in current directory when no source given.
+// empty string returned when there is no manifest file
+func Find(sources []string) string {
+	dir := "."
+	if len(sources) > 0 {
+		if utils.IsDir(sources[0]) {
+			dir = sources[0]
+		} else {
+			dir = filepath.Dir(sources[0])
+		}
+	}
+	file := filepath.Join(dir, FileName)
+	if !utils.IsRegularFile(file) {
+		return ""
+	}
+	return file
+}
+
+// Load a manifest file
+func Load(file string) (*Manifest, error) {
+	body, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	m, err := Parse(body)
+	if err != nil {
+		return nil, fmt.Errorf("invalid manifest %s:\n%s", file, err)
+	}
+	m.dir = filepath.Dir(file)
+	return m, nil
+}
+
+// Parse and validate manifest content, errors are reported with line number
+func Parse(body []byte) (*Manifest, error) {
+	var root yaml.Node
+	if err := yaml.Unmarshal(body, &root); err != nil {
+		return nil, err
+	}
+	m := &Manifest{}
+	if len(root.Content) == 0 {
+		return m, nil
+	}
+
+	doc := root.Content[0]
+	if errs := validate(doc); len(errs) > 0 {
+		return nil, fmt.Errorf("%s", strings.Join(errs, "\n"))
+	}
+	if err := doc.Decode(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// SourcePaths paths of sources, relative to the directory of manifest file
+func (m *Manifest) SourcePaths() []string {
+	paths := []string{}
+	for _, s := range m.Sources {
+		if filepath.IsAbs(s) {
+			paths = append(paths, s)
+		} else {
+			paths = append(paths, filepath.Join(m.dir, s))
+		}
+	}
+	return paths
+}
+
+// IsProject if manifest declares a project of functions
+func (m *Manifest) IsProject() bool {
+	return len(m.Functions) > 0
+}
+
+// FunctionManifests manifests of functions in project, settings shared on top level
+// are filled in when function does not have its own
+func (m *Manifest) FunctionManifests() []*Manifest {
+	fns := []*Manifest{}
+	for _, f := range m.Functions {
+		fn := *f
+		fn.dir = m.dir
+		fn.Infra = m.Infra
+		if fn.Language == "" {
+			fn.Language = m.Language
+		}
+		if fn.Replicas == 0 {
+			fn.Replicas = m.Replicas
+		}
+		if fn.Memory == "" {
+			fn.Memory = m.Memory
+		}
+		if fn.CPUs == "" {
+			fn.CPUs = m.CPUs
+		}
+		if fn.Restart == "" {
+			fn.Restart = m.Restart
+		}
+		if fn.HealthPath == "" {
+			fn.HealthPath = m.HealthPath
+		}
+		fn.Env = mergeMap(m.Env, f.Env)
+		fn.Secrets = mergeList(m.Secrets, f.Secrets)
+		fn.BuildArgs = mergeMap(m.BuildArgs, f.BuildArgs)
+		fns = append(fns, &fn)
+	}
+	return fns
+}
+
+func mergeMap(base map[string]string, override map[string]string) map[string]string {
+	merged := map[string]string{}
+	for k, v := range base {
+		merged[k] = v
+	}
+	for k, v := range override {
+		merged[k] = v
+	}
+	return merged
+}
+
+func mergeList(base []string, more []string) []string {
+	merged := []string{}
+	seen := map[string]bool{}
+	for _, v := range append(append([]string{}, base...), more...) {
+		if !seen[v] {
+			seen[v] = true
+			merged = append(merged, v)
+		}
+	}
+	return merged
+}
+
+// Resources memory limit in bytes and count of CPUs, 0 when they're not declared
+func (m *Manifest) Resources() (int64, float64) {
+	// they are validated already
+	var memory int64
+	var cpus float64
+	if m.Memory != "" {
+		memory, _ = types.ParseMemory(m.Memory)
+	}
+	if m.CPUs != "" {
+		cpus, _ = types.ParseCPUs(m.CPUs)
+	}
+	return memory, cpus
+}
+
+// Bindings port bindings declared by ports
+func (m *Manifest) Bindings() []types.PortBinding {
+	bindings := []types.PortBinding{}
+	for _, p := range m.Ports {
+		// ports are validated already
+		host, container, _ := parsePort(p)
+		bindings = append(bindings, types.PortBinding{
+			ServiceBindingPort:  host,
+			ContainerExposePort: container,
+		})
+	}
+	return bindings
+}
+
+// parsePort parse port in <host port>[:<container port>] format,
+// container port is the fx container expose port by default
+func parsePort(port string) (int32, int32, error) {
+	parts := strings.Split(port, ":")
+	if len(parts) > 2 {
+		return 0, 0, fmt.Errorf("port %s should be in <host port>[:<container port>] format", port)
+	}
+	host, err := parsePortNumber(parts[0])
+	if err != nil {
+		return 0, 0, err
+	}
+	container := int32(constants.FxContainerExposePort)
+	if len(parts) == 2 {
+		container, err = parsePortNumber(parts[1])
+		if err != nil {
+			return 0, 0, err
+		}
+	}
+	return host, container, nil
+}
+
+func parsePortNumber(s string) (int32, error) {
+	n, err := strconv.Atoi(s)
+	if err != nil || n < 1 || n > 65535 {
+		return 0, fmt.Errorf("invalid port number %s, it should be in range of 1 - 65535", s)
+	}
+	return int32(n), nil
+}
+
+type validator func(field string, node *yaml.Node) []string
+
+var validators = map[string]validator{
+	"name": scalar(func(v string) error {
+		if !namePattern.MatchString(v) {
+			return fmt.Errorf("%s should match %s", v, namePattern.String())
+		}
+		return nil
+	}),
+	"sources": sequence(func(v string) error {
+		if v == "" {
+			return fmt.Errorf("should not be empty")
+		}
+		return nil
+	}),
+	"language": scalar(func(v string) error {
+		languages := packer.Languages()
+		for _, lang := range languages {
+			if v == lang {
+				return nil
+			}
+		}
+		return fmt.Errorf("%s is not supported, it should be one of %s", v, strings.Join(languages, ", "))
+	}),
+	"ports": sequence(func(v string) error {
+		_, _, err := parsePort(v)
+		return err
+	}),
+	"env": mapping(keyPattern),
+	"secrets": sequence(func(v string) error {
+		if !keyPattern.MatchString(v) {
+			return fmt.Errorf("invalid secret name %s, it should match %s", v, keyPattern.String())
+		}
+		return nil
+	}),
+	"replicas": scalar(func(v string) error {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 {
+			return fmt.Errorf("%s should be a positive number", v)
+		}
+		return nil
+	}),
+	"memory": scalar(func(v string) error {
+		_, err := types.ParseMemory(v)
+		return err
+	}),
+	"cpus": scalar(func(v string) error {
+		_, err := types.ParseCPUs(v)
+		return err
+	}),
+	"restart": scalar(func(v string) error {
+		_, err := types.ParseRestartPolicy(v)
+		return err
+	}),
+	"health_path": scalar(func(v string) error {
+		_, err := types.ParseHealthPath(v)
+		return err
+	}),
+	"infra": scalar(func(v string) error {
+		if v == "" {
+			return fmt.Errorf("should not be empty")
+		}
+		return nil
+	}),
+	"build_args": mapping(keyPattern),
+}
+
+// functions validate functions of project, every function requires a unique name and sources
+func functions(field string, node *yaml.Node) []string {
+	if node.Kind != yaml.SequenceNode {
+		return []string{fmt.Sprintf("line %d: %s should be a list", node.Line, field)}
+	}
+	errs := []string{}
+	names := map[string]int{}
+	for _, fn := range node.Content {
+		if fn.Kind != yaml.MappingNode {
+			errs = append(errs, fmt.Sprintf("line %d: %s: function should be a mapping", fn.Line, field))
+			continue
+		}
+		fields := map[string]bool{}
+		for i := 0; i+1 < len(fn.Content); i += 2 {
+			key, value := fn.Content[i], fn.Content[i+1]
+			fields[key.Value] = true
+			switch key.Value {
+			case "functions", "infra":
+				errs = append(errs, fmt.Sprintf("line %d: %s: %s is not allowed in function", key.Line, field, key.Value))
+				continue
+			case "name":
+				if line, ok := names[value.Value]; ok {
+					errs = append(errs, fmt.Sprintf("line %d: %s: name %s is already used on line %d", value.Line, field, value.Value, line))
+				}
+				names[value.Value] = value.Line
+			}
+		}
+		for _, required := range []string{"name", "sources"} {
+			if !fields[required] {
+				errs = append(errs, fmt.Sprintf("line %d: %s: %s of function required", fn.Line, field, required))
+			}
+		}
+		errs = append(errs, validate(fn)...)
+	}
+	return errs
+}
+
+func validate(doc *yaml.Node) []string {
+	if doc.Kind != yaml.MappingNode {
+		return []string{fmt.Sprintf("line %d: manifest should be a mapping", doc.Line)}
+	}
+	errs := []string{}
+	for i := 0; i+1 < len(doc.Content); i += 2 {
+		key, value := doc.Content[i], doc.Content[i+1]
+		if key.Value == "functions" {
+			errs = append(errs, functions(key.Value, value)...)
+			continue
+		}
+		fn, ok := validators[key.Value]
+		if !ok {
+			errs = append(errs, fmt.Sprintf("line %d: unknown field %s", key.Line, key.Value))
+			continue
+		}
+		errs = append(errs, fn(key.Value, value)...)
+	}
+	return errs
+}
+
+func scalar(check func(v string) error) validator {
+	return func(field string, node *yaml.Node) []string {
+		if node.Kind != yaml.ScalarNode {
+			return []string{fmt.Sprintf("line %d: %s should be a single value", node.Line, field)}
+		}
+		if err := check(node.Value); err != nil {
+			return []string{fmt.Sprintf("line %d: %s: %s", node.Line, field, err)}
+		}
+		return nil
+	}
+}
+
+func sequence(check func(v string) error) validator {
+	return func(field string, node *yaml.Node) []string {
+		if node.Kind != yaml.SequenceNode {
+			return []string{fmt.Sprintf("line %d: %s should be a list", node.Line, field)}
+		}
+		errs := []string{}
+		for _, item := range node.Content {
+			errs = append(errs, scalar(check)(field, item)...)
+		}
+		return errs
+	}
+}
+
+func mapping(keyPattern *regexp.Regexp) validator {
+	return func(field string, node *yaml.Node) []string {
+		if node.Kind != yaml.MappingNode {
+			return []string{fmt.Sprintf("line %d: %s should be a mapping of key and value", node.Line, field)}
+		}
+		errs := []string{}
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key, value := node.Content[i], node.Content[i+1]
+			if !keyPattern.MatchString(key.Value) {
+				errs = append(errs, fmt.Sprintf("line %d: %s: invalid key %s, it should match %s", key.Line, field, key.Value, keyPattern.String()))
+			}
+			if value.Kind != yaml.ScalarNode {
+				errs = append(errs, fmt.Sprintf("line %d: %s: value of %s should be a single value", value.Line, field, key.Value))
+			}
+		}
+		return errs
+	}
+}
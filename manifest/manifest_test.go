@@ -0,0 +1,220 @@
+package manifest
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/metrue/fx/types"
+)
+
+func TestParse(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		body := `
+name: hello
+sources:
+  - fx.js
+language: node
+ports:
+  - 8080
+  - "8443:3001"
+env:
+  GREETING: hello
+secrets:
+  - DB_PASSWORD
+replicas: 2
+memory: 512m
+cpus: 0.5
+restart: on-failure:3
+health_path: /health
+infra: my-k8s
+build_args:
+  NPM_REGISTRY: https://registry.npmjs.org
+`
+		m, err := Parse([]byte(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if m.Name != "hello" || m.Language != "node" || m.Infra != "my-k8s" || m.Replicas != 2 {
+			t.Fatalf("unexpected manifest %+v", m)
+		}
+		if m.Env["GREETING"] != "hello" {
+			t.Fatalf("should get %s but got %s", "hello", m.Env["GREETING"])
+		}
+		if !reflect.DeepEqual(m.Secrets, []string{"DB_PASSWORD"}) {
+			t.Fatalf("should get %v but got %v", []string{"DB_PASSWORD"}, m.Secrets)
+		}
+		if memory, cpus := m.Resources(); memory != 512*1024*1024 || cpus != 0.5 {
+			t.Fatalf("should get %d and %f but got %d and %f", 512*1024*1024, 0.5, memory, cpus)
+		}
+		if m.Restart != "on-failure:3" || m.HealthPath != "/health" {
+			t.Fatalf("should get %s and %s but got %s and %s", "on-failure:3", "/health", m.Restart, m.HealthPath)
+		}
+		if m.BuildArgs["NPM_REGISTRY"] != "https://registry.npmjs.org" {
+			t.Fatalf("should get %s but got %s", "https://registry.npmjs.org", m.BuildArgs["NPM_REGISTRY"])
+		}
+
+		expected := []types.PortBinding{
+			types.PortBinding{ServiceBindingPort: 8080, ContainerExposePort: 3000},
+			types.PortBinding{ServiceBindingPort: 8443, ContainerExposePort: 3001},
+		}
+		if !reflect.DeepEqual(m.Bindings(), expected) {
+			t.Fatalf("should get %v but got %v", expected, m.Bindings())
+		}
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		m, err := Parse([]byte(""))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if m.Name != "" || len(m.Sources) != 0 {
+			t.Fatalf("should get an empty manifest but got %+v", m)
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		body := `name: hello
+language: cobol
+ports:
+  - 8080
+  - 70000
+replicas: 0
+env:
+  1BAD: value
+unknown: field
+secrets:
+  - bad-name
+memory: lots
+cpus: -1
+restart: sometimes
+health_path: health
+`
+		_, err := Parse([]byte(body))
+		if err == nil {
+			t.Fatalf("should get error with invalid manifest")
+		}
+		for _, expected := range []string{
+			"line 2: language: cobol is not supported",
+			"line 5: ports: invalid port number 70000",
+			"line 6: replicas: 0 should be a positive number",
+			"line 8: env: invalid key 1BAD",
+			"line 9: unknown field unknown",
+			"line 11: secrets: invalid secret name bad-name",
+			"line 12: memory: invalid memory lots",
+			"line 13: cpus: invalid cpus -1",
+			"line 14: restart: invalid restart policy sometimes",
+			"line 15: health_path: invalid health path health",
+		} {
+			if !strings.Contains(err.Error(), expected) {
+				t.Fatalf("should get %s in error but got %s", expected, err)
+			}
+		}
+	})
+}
+
+func TestLoad(t *testing.T) {
+	dir, err := ioutil.TempDir("", "fx-manifest")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	source := filepath.Join(dir, "fx.js")
+	if err := ioutil.WriteFile(source, []byte(""), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if file := Find([]string{source}); file != "" {
+		t.Fatalf("should not find manifest but got %s", file)
+	}
+
+	body := "name: hello\nsources:\n  - fx.js\n"
+	if err := ioutil.WriteFile(filepath.Join(dir, FileName), []byte(body), 0644); err != nil {
+		t.Fatal(err)
+	}
+	file := Find([]string{dir})
+	if file != filepath.Join(dir, FileName) {
+		t.Fatalf("should get %s but got %s", filepath.Join(dir, FileName), file)
+	}
+
+	m, err := Load(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(m.SourcePaths(), []string{source}) {
+		t.Fatalf("should get %v but got %v", []string{source}, m.SourcePaths())
+	}
+}
+
+func TestProject(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		body := `
+infra: my-k8s
+memory: 256m
+env:
+  GREETING: hello
+  TARGET: world
+functions:
+  - name: hello
+    sources:
+      - hello/fx.js
+    ports:
+      - 8080
+  - name: world
+    sources:
+      - world/fx.py
+    memory: 1g
+    env:
+      TARGET: fx
+`
+		m, err := Parse([]byte(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !m.IsProject() {
+			t.Fatalf("should be a project")
+		}
+		fns := m.FunctionManifests()
+		if len(fns) != 2 {
+			t.Fatalf("should get %d functions but got %d", 2, len(fns))
+		}
+		if fns[0].Infra != "my-k8s" || fns[0].Env["TARGET"] != "world" {
+			t.Fatalf("should inherit settings of project but got %+v", fns[0])
+		}
+		if fns[0].Memory != "256m" || fns[1].Memory != "1g" {
+			t.Fatalf("should get memory %s and %s but got %s and %s", "256m", "1g", fns[0].Memory, fns[1].Memory)
+		}
+		if fns[1].Env["TARGET"] != "fx" || fns[1].Env["GREETING"] != "hello" {
+			t.Fatalf("should override settings of project but got %+v", fns[1])
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		body := `functions:
+  - name: hello
+    sources:
+      - fx.js
+  - name: hello
+    infra: other
+  - sources:
+      - fx.py
+`
+		_, err := Parse([]byte(body))
+		if err == nil {
+			t.Fatalf("should get error with invalid project")
+		}
+		for _, expected := range []string{
+			"line 5: functions: name hello is already used on line 2",
+			"line 6: functions: infra is not allowed in function",
+			"line 5: functions: sources of function required",
+			"line 7: functions: name of function required",
+		} {
+			if !strings.Contains(err.Error(), expected) {
+				t.Fatalf("should get %s in error but got %s", expected, err)
+			}
+		}
+	})
+}
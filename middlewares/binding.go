@@ -22,11 +22,18 @@ var PortRange = struct {
 // Binding create bindings
 func Binding(ctx context.Contexter) (err error) {
 	port := ctx.Get("port").(int)
+	// port bindings declared in manifest are used when there is no --port
+	if ports, ok := ctx.Get("ports").([]types.PortBinding); ok && port == 0 && len(ports) > 0 {
+		ctx.Set("bindings", ports)
+		return nil
+	}
 	if port == 0 {
 		port, err = freeport.GetFreePort()
 		if err != nil {
 			return err
 		}
+		// a random port is for a new service only, a service updated keeps its ports
+		ctx.Set("random_port", true)
 	}
 	if port < PortRange.min || port > PortRange.max {
 		return fmt.Errorf("invalid port number: %d, port number should in range of %d -  %d", port, PortRange.min, PortRange.max)
@@ -5,16 +5,37 @@ import (
 
 	"github.com/golang/mock/gomock"
 	mockCtx "github.com/metrue/fx/context/mocks"
+	"github.com/metrue/fx/types"
 )
 
 func TestBinding(t *testing.T) {
-	ctrl := gomock.NewController(t)
-	defer ctrl.Finish()
+	t.Run("port", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
 
-	ctx := mockCtx.NewMockContexter(ctrl)
-	ctx.EXPECT().Get("port").Return(0)
-	ctx.EXPECT().Set("bindings", gomock.Any())
-	if err := Binding(ctx); err != nil {
-		t.Fatal(err)
-	}
+		ctx := mockCtx.NewMockContexter(ctrl)
+		ctx.EXPECT().Get("port").Return(0)
+		ctx.EXPECT().Get("ports").Return(nil)
+		ctx.EXPECT().Set("random_port", true)
+		ctx.EXPECT().Set("bindings", gomock.Any())
+		if err := Binding(ctx); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	t.Run("manifest", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ports := []types.PortBinding{
+			types.PortBinding{ServiceBindingPort: 8080, ContainerExposePort: 3000},
+		}
+		ctx := mockCtx.NewMockContexter(ctrl)
+		ctx.EXPECT().Get("port").Return(0)
+		ctx.EXPECT().Get("ports").Return(ports)
+		ctx.EXPECT().Set("bindings", ports)
+		if err := Binding(ctx); err != nil {
+			t.Fatal(err)
+		}
+	})
 }
@@ -2,14 +2,20 @@ package middlewares
 
 import (
 	"fmt"
+	"io/ioutil"
 	"os"
-	"time"
+	"strings"
 
+	"github.com/apex/log"
+	dockerTypes "github.com/docker/docker/api/types"
 	"github.com/metrue/fx/config"
 	containerruntimes "github.com/metrue/fx/container_runtimes"
 	"github.com/metrue/fx/context"
+	"github.com/metrue/fx/infra"
 	"github.com/metrue/fx/packer"
+	"github.com/metrue/fx/pkg/failure"
 	"github.com/metrue/fx/pkg/spinner"
+	"github.com/metrue/fx/registry"
 	"github.com/metrue/fx/utils"
 	"github.com/otiai10/copy"
 )
@@ -20,42 +26,33 @@ func Build(ctx context.Contexter) (err error) {
 	spinner.Start(task)
 	defer func() {
 		spinner.Stop(task, err)
+		err = failure.Build(err)
 	}()
 
-	workdir := fmt.Sprintf("/tmp/fx-%d", time.Now().Unix())
+	// functions of a project are built concurrently, each of them needs its own workdir
+	workdir, err := ioutil.TempDir("", "fx-")
+	if err != nil {
+		return err
+	}
 	defer os.RemoveAll(workdir)
 
-	// Cases supports
-	// 1. a single file function
-	// 		fx up func.js
-	// 2. a directory with Docker in it
-	// 		fx up ./func/
-	// 3. a directory without Dockerfile in it, but has fx handle function file
-	// 4. a fx handlefunction file and its dependencies files or/and directory
-	// 		fx up func.js helper.js ./lib/
-
-	// When only one directory given and there is a Dockerfile in given directory, treat it as a containerized project and skip packing
-	sources := ctx.Get("sources").([]string)
-
-	if len(sources) == 0 {
-		return fmt.Errorf("source file/directory of function required")
+	if err := Pack(ctx, workdir); err != nil {
+		return err
 	}
 
-	if len(sources) == 1 &&
-		utils.IsDir(sources[0]) &&
-		utils.HasDockerfile(sources[0]) {
-		if err := copy.Copy(sources[0], workdir); err != nil {
+	cloudType := ctx.Get("cloud_type").(string)
+	name := ctx.Get("name").(string)
+	buildArgs, _ := ctx.Get("build_args").(map[string]string)
+	if builder, ok := ctx.Get("builder").(infra.Builder); ok {
+		image, err := builder.BuildImage(ctx.GetContext(), name, workdir, buildArgs)
+		if err != nil {
 			return err
 		}
-	} else {
-		if err := packer.Pack(workdir, sources...); err != nil {
-			return err
+		ctx.Set("image", image)
+	} else if cloudType == config.CloudTypeK8S && os.Getenv("K3S") == "" {
+		if len(buildArgs) > 0 {
+			log.Warnf("build args are ignored, image is built by init container of deployment on Kubernetes, configure a registry to build it in cluster instead")
 		}
-	}
-
-	cloudType := ctx.Get("cloud_type").(string)
-	name := ctx.Get("name").(string)
-	if cloudType == config.CloudTypeK8S && os.Getenv("K3S") == "" {
 		data, err := packer.PackIntoK8SConfigMapFile(workdir)
 		if err != nil {
 			return err
@@ -63,7 +60,7 @@ func Build(ctx context.Contexter) (err error) {
 		ctx.Set("data", data)
 	} else {
 		docker := ctx.Get("docker").(containerruntimes.ContainerRuntime)
-		if err := docker.BuildImage(ctx.GetContext(), workdir, name); err != nil {
+		if err := docker.BuildImage(ctx.GetContext(), workdir, name, buildArgs); err != nil {
 			return err
 		}
 
@@ -73,17 +70,78 @@ func Build(ctx context.Contexter) (err error) {
 		}
 		ctx.Set("image", nameWithTag)
 
-		if os.Getenv("K3S") != "" {
+		if repository, _ := ctx.Get("registry").(string); repository != "" {
+			store, err := registry.LoadDefault()
+			if err != nil {
+				return err
+			}
+			image := strings.TrimSuffix(repository, "/") + "/" + name
+			auth, err := store.EncodedAuth(image)
+			if err != nil {
+				return err
+			}
+			pinned, err := push(ctx, docker, name, image, auth)
+			if err != nil {
+				return err
+			}
+			ctx.Set("image", pinned)
+		} else if os.Getenv("K3S") != "" {
 			username := os.Getenv("DOCKER_USERNAME")
 			password := os.Getenv("DOCKER_PASSWORD")
 			if username != "" && password != "" {
-				if _, err := docker.PushImage(ctx.GetContext(), name); err != nil {
+				auth, err := registry.Encode(dockerTypes.AuthConfig{
+					Username:      username,
+					Password:      password,
+					ServerAddress: registry.DockerHub,
+				})
+				if err != nil {
 					return err
 				}
-				ctx.Set("image", username+"/"+name)
+				pinned, err := push(ctx, docker, name, username+"/"+name, auth)
+				if err != nil {
+					return err
+				}
+				ctx.Set("image", pinned)
 			}
 		}
 	}
 
 	return nil
 }
+
+// Pack sources of function in context into a Docker project in workdir. Cases supports
+//  1. a single file function
+//     fx up func.js
+//  2. a directory with Docker in it
+//     fx up ./func/
+//  3. a directory without Dockerfile in it, but has fx handle function file
+//  4. a fx handlefunction file and its dependencies files or/and directory
+//     fx up func.js helper.js ./lib/
+func Pack(ctx context.Contexter, workdir string) error {
+	sources := ctx.Get("sources").([]string)
+	if len(sources) == 0 {
+		return failure.Usage(fmt.Errorf("source file/directory of function required"))
+	}
+
+	// When only one directory given and there is a Dockerfile in given directory, treat it as a containerized project and skip packing
+	if len(sources) == 1 &&
+		utils.IsDir(sources[0]) &&
+		utils.HasDockerfile(sources[0]) {
+		return copy.Copy(sources[0], workdir)
+	}
+	language, _ := ctx.Get("language").(string)
+	return packer.PackWithLanguage(workdir, language, sources...)
+}
+
+// push image built with name to registry as image, it's referenced by the digest pushed,
+// so a deployment runs exactly the image built even when the tag is pushed again later
+func push(ctx context.Contexter, docker containerruntimes.ContainerRuntime, name string, image string, auth string) (string, error) {
+	if err := docker.TagImage(ctx.GetContext(), name, image+":latest"); err != nil {
+		return "", err
+	}
+	digest, err := docker.PushImage(ctx.GetContext(), image+":latest", auth)
+	if err != nil {
+		return "", err
+	}
+	return image + "@" + digest, nil
+}
@@ -0,0 +1,24 @@
+package middlewares
+
+import (
+	"github.com/apex/log"
+	containerruntimes "github.com/metrue/fx/container_runtimes"
+	"github.com/metrue/fx/context"
+	"github.com/metrue/fx/pkg/gateway"
+)
+
+// Gateway run fn, then refresh route table of fx gateway with the functions running,
+// it's refreshed even when fn failed since some of functions could be deployed or destroyed already
+func Gateway(fn func(ctx context.Contexter) error) func(ctx context.Contexter) error {
+	return func(ctx context.Contexter) error {
+		err := fn(ctx)
+
+		// gateway is only available on Docker infrastructure
+		if docker, ok := ctx.Get("docker").(containerruntimes.ContainerRuntime); ok {
+			if refreshErr := gateway.Refresh(ctx.GetContext(), docker); refreshErr != nil {
+				log.Warnf("could not refresh routes of gateway: %v", refreshErr)
+			}
+		}
+		return err
+	}
+}
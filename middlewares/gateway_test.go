@@ -0,0 +1,29 @@
+package middlewares
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/metrue/fx/context"
+	mockCtx "github.com/metrue/fx/context/mocks"
+)
+
+func TestGateway(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := mockCtx.NewMockContexter(ctrl)
+	ctx.EXPECT().Get("docker").Return(nil)
+	called := false
+	err := Gateway(func(ctx context.Contexter) error {
+		called = true
+		return fmt.Errorf("deploy failed")
+	})(ctx)
+	if !called {
+		t.Fatalf("should call the wrapped function")
+	}
+	if err == nil || err.Error() != "deploy failed" {
+		t.Fatalf("should get error of the wrapped function but got %v", err)
+	}
+}
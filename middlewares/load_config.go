@@ -3,13 +3,14 @@ package middlewares
 import (
 	"github.com/metrue/fx/config"
 	"github.com/metrue/fx/context"
+	"github.com/metrue/fx/pkg/failure"
 )
 
 // LoadConfig load default config
 func LoadConfig(ctx context.Contexter) error {
 	config, err := config.LoadDefault()
 	if err != nil {
-		return err
+		return failure.Config(err)
 	}
 	ctx.Set("config", config)
 	return nil
@@ -0,0 +1,35 @@
+package middlewares
+
+import (
+	"github.com/metrue/fx/context"
+	"github.com/metrue/fx/pkg/failure"
+	"github.com/metrue/fx/pkg/render"
+)
+
+// Output create the renderer of output format given by command line, flags of command override the global ones
+func Output(ctx context.Contexter) error {
+	cli := ctx.GetCliContext()
+	output := cli.GlobalString("output")
+	if cli.IsSet("output") {
+		output = cli.String("output")
+	}
+	format := cli.GlobalString("format")
+	if cli.IsSet("format") {
+		format = cli.String("format")
+	}
+	renderer, err := render.New(output, format)
+	if err != nil {
+		return failure.Usage(err)
+	}
+	ctx.Set("renderer", renderer)
+	return nil
+}
+
+// RendererOf the renderer created by Output, it's table when there is none
+func RendererOf(ctx context.Contexter) render.Renderer {
+	if renderer, ok := ctx.Get("renderer").(render.Renderer); ok {
+		return renderer
+	}
+	renderer, _ := render.New(render.OutputTable, "")
+	return renderer
+}
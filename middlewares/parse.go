@@ -2,36 +2,153 @@ package middlewares
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/google/uuid"
 	"github.com/metrue/fx/context"
+	"github.com/metrue/fx/manifest"
+	"github.com/metrue/fx/pkg/failure"
+	"github.com/metrue/fx/secret"
+	"github.com/metrue/fx/types"
+	"github.com/metrue/fx/utils"
+	"github.com/urfave/cli"
 )
 
 // Parse parse input
 func Parse(action string) func(ctx context.Contexter) (err error) {
-	return func(ctx context.Contexter) error {
+	return func(ctx context.Contexter) (err error) {
+		// errors of a manifest are config errors, the others are caused by invalid arguments
+		defer func() {
+			err = failure.Usage(err)
+		}()
+
 		cli := ctx.GetCliContext()
+		// namespace of Kubernetes infrastructure, it's ignored by the others
+		ctx.Set("namespace", cli.String("namespace"))
 		switch action {
 		case "up":
 			sources := []string{}
 			for _, s := range cli.Args() {
 				sources = append(sources, s)
 			}
-			ctx.Set("sources", sources)
-			name := cli.String("name")
-			ctx.Set("name", name)
-			port := cli.Int("port")
-			ctx.Set("port", port)
+
+			// settings in manifest file are overridden by CLI flags and arguments
+			m := &manifest.Manifest{}
+			file := cli.String("manifest")
+			if file == "" {
+				file = manifest.Find(sources)
+			}
+			if file != "" {
+				var err error
+				if m, err = manifest.Load(file); err != nil {
+					return failure.Config(err)
+				}
+			}
+			ctx.Set("infra", m.Infra)
+
+			// environment variables in manifest < --env-file < --env
+			env := map[string]string{}
+			if file := cli.String("env-file"); file != "" {
+				fileEnv, err := utils.ParseEnvFile(file)
+				if err != nil {
+					return err
+				}
+				env = fileEnv
+			}
+			flagEnv, err := utils.ParseEnv(cli.StringSlice("env"))
+			if err != nil {
+				return err
+			}
+			for k, v := range flagEnv {
+				env[k] = v
+			}
+			secrets := cli.StringSlice("secret")
+			limits, err := limitsOf(cli)
+			if err != nil {
+				return err
+			}
+			ctx.Set("healthcheck", cli.Bool("healthcheck"))
+			ctx.Set("healthcheck_timeout", cli.Duration("healthcheck-timeout"))
+
+			// a project of functions is deployed only when no source is given
+			if m.IsProject() && len(sources) == 0 {
+				if cli.String("name") != "" || cli.Int("port") != 0 {
+					return fmt.Errorf("--name and --port are not supported when deploying a project of functions")
+				}
+				functions := m.FunctionManifests()
+				for _, fn := range functions {
+					overrideEnv(fn, env, secrets)
+					overrideLimits(fn, limits)
+				}
+				ctx.Set("functions", functions)
+				ctx.Set("parallel", cli.Int("parallel"))
+				return nil
+			}
+			overrideEnv(m, env, secrets)
+			overrideLimits(m, limits)
+
+			if len(sources) == 0 {
+				sources = m.SourcePaths()
+			}
+			if name := cli.String("name"); name != "" {
+				m.Name = name
+			}
+			if err := setFunction(ctx, m, sources, cli.Int("port")); err != nil {
+				return err
+			}
 		case "down":
 			services := cli.Args()
-			if len(services) == 0 {
+			all := cli.Bool("all")
+			if all && len(services) > 0 {
+				return fmt.Errorf("service name should not be given with --all")
+			}
+			if !all && len(services) == 0 {
 				return fmt.Errorf("service name required")
 			}
+			ctx.Set("all", all)
+			ctx.Set("dry_run", cli.Bool("dry-run"))
+			ctx.Set("include_unlabeled", cli.Bool("include-unlabeled"))
 			svc := []string{}
 			for _, service := range services {
 				svc = append(svc, service)
 			}
 			ctx.Set("services", svc)
+		case "call":
+			// arguments in key=value format are params, others are sources
+			sources := []string{}
+			pairs := []string{}
+			for _, arg := range cli.Args() {
+				if strings.Contains(arg, "=") && !utils.IsRegularFile(arg) && !utils.IsDir(arg) {
+					pairs = append(pairs, arg)
+				} else {
+					sources = append(sources, arg)
+				}
+			}
+			service := cli.String("service")
+			if service == "" && len(sources) == 0 {
+				return fmt.Errorf("source file/directory of function or --service required")
+			}
+			ctx.Set("sources", sources)
+			ctx.Set("params", utils.PairsToParams(pairs))
+			ctx.Set("service", service)
+			ctx.Set("host", cli.String("host"))
+		case "logs":
+			service := cli.Args().First()
+			if service == "" {
+				return fmt.Errorf("service name required")
+			}
+			ctx.Set("service", service)
+			ctx.Set("log_options", types.LogOptions{
+				Follow: cli.Bool("follow"),
+				Since:  cli.String("since"),
+				Tail:   cli.String("tail"),
+			})
+		case "gateway_start":
+			port := cli.Int("port")
+			if port < 1 || port > 65535 {
+				return fmt.Errorf("invalid port number: %d, port number should in range of 1 - 65535", port)
+			}
+			ctx.Set("port", port)
 		case "list":
 			name := cli.Args().First()
 			ctx.Set("filter", name)
@@ -62,3 +179,101 @@ func Parse(action string) func(ctx context.Contexter) (err error) {
 		return nil
 	}
 }
+
+// overrideEnv override environment variables and secrets of manifest with the ones from CLI
+func overrideEnv(m *manifest.Manifest, env map[string]string, secrets []string) {
+	if len(env) > 0 && m.Env == nil {
+		m.Env = map[string]string{}
+	}
+	for k, v := range env {
+		m.Env[k] = v
+	}
+	for _, s := range secrets {
+		found := false
+		for _, existing := range m.Secrets {
+			found = found || existing == s
+		}
+		if !found {
+			m.Secrets = append(m.Secrets, s)
+		}
+	}
+}
+
+// limitsOf resources, restart policy and health path given by command line, they're validated as manifest does
+func limitsOf(c *cli.Context) (*manifest.Manifest, error) {
+	limits := &manifest.Manifest{
+		Memory:     c.String("memory"),
+		CPUs:       c.String("cpus"),
+		Restart:    c.String("restart"),
+		HealthPath: c.String("health-path"),
+	}
+	if limits.Memory != "" {
+		if _, err := types.ParseMemory(limits.Memory); err != nil {
+			return nil, err
+		}
+	}
+	if limits.CPUs != "" {
+		if _, err := types.ParseCPUs(limits.CPUs); err != nil {
+			return nil, err
+		}
+	}
+	if limits.Restart != "" {
+		if _, err := types.ParseRestartPolicy(limits.Restart); err != nil {
+			return nil, err
+		}
+	}
+	if limits.HealthPath != "" {
+		if _, err := types.ParseHealthPath(limits.HealthPath); err != nil {
+			return nil, err
+		}
+	}
+	return limits, nil
+}
+
+func overrideLimits(m *manifest.Manifest, limits *manifest.Manifest) {
+	if limits.Memory != "" {
+		m.Memory = limits.Memory
+	}
+	if limits.CPUs != "" {
+		m.CPUs = limits.CPUs
+	}
+	if limits.Restart != "" {
+		m.Restart = limits.Restart
+	}
+	if limits.HealthPath != "" {
+		m.HealthPath = limits.HealthPath
+	}
+}
+
+// setFunction set the settings of a function to be deployed, values of secrets are loaded from secret store
+func setFunction(ctx context.Contexter, m *manifest.Manifest, sources []string, port int) error {
+	name := m.Name
+	if name == "" {
+		name = uuid.New().String()
+	}
+	var secrets map[string]string
+	if len(m.Secrets) > 0 {
+		store, err := secret.LoadDefault()
+		if err != nil {
+			return err
+		}
+		if secrets, err = store.Get(m.Secrets...); err != nil {
+			return err
+		}
+	}
+	ctx.Set("sources", sources)
+	ctx.Set("name", name)
+	ctx.Set("port", port)
+	ctx.Set("ports", m.Bindings())
+	ctx.Set("language", m.Language)
+	ctx.Set("env", m.Env)
+	ctx.Set("secrets", secrets)
+	ctx.Set("replicas", m.Replicas)
+	memory, cpus := m.Resources()
+	ctx.Set("memory", memory)
+	ctx.Set("cpus", cpus)
+	ctx.Set("restart", m.Restart)
+	ctx.Set("health_path", m.HealthPath)
+	ctx.Set("build_args", m.BuildArgs)
+	return nil
+}
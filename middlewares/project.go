@@ -0,0 +1,99 @@
+package middlewares
+
+import (
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/metrue/fx/context"
+	"github.com/metrue/fx/manifest"
+	"github.com/metrue/fx/pkg/failure"
+	"github.com/metrue/fx/pkg/render"
+	"github.com/metrue/fx/types"
+)
+
+// DefaultParallel how many functions of a project are deployed at the same time by default
+const DefaultParallel = 4
+
+// Project run steps for every function of a project concurrently with a bounded number of workers,
+// a function failed does not stop the others, results of all functions are reported when they're done.
+// Steps run once with ctx itself when it's not a project.
+func Project(steps ...func(ctx context.Contexter) error) func(ctx context.Contexter) error {
+	return func(ctx context.Contexter) error {
+		functions, ok := ctx.Get("functions").([]*manifest.Manifest)
+		if !ok || len(functions) == 0 {
+			for _, step := range steps {
+				if err := step(ctx); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+
+		parallel, _ := ctx.Get("parallel").(int)
+		if parallel <= 0 {
+			parallel = DefaultParallel
+		}
+
+		results := make([]render.Result, len(functions))
+		jobs := make(chan int)
+		var wg sync.WaitGroup
+		for w := 0; w < parallel && w < len(functions); w++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for i := range jobs {
+					results[i] = runFunction(ctx, functions[i], steps)
+				}
+			}()
+		}
+		for i := range functions {
+			jobs <- i
+		}
+		close(jobs)
+		wg.Wait()
+
+		if err := RendererOf(ctx).Results(os.Stdout, results); err != nil {
+			return err
+		}
+
+		// kind of the first failure is the kind of the project failure
+		failed := 0
+		kind := failure.KindUnknown
+		for _, r := range results {
+			if r.Err != nil {
+				if failed == 0 {
+					kind = failure.KindOf(r.Err)
+				}
+				failed++
+			}
+		}
+		if failed > 0 {
+			return failure.New(kind, fmt.Errorf("%d of %d functions failed", failed, len(functions)))
+		}
+		return nil
+	}
+}
+
+// runFunction run steps for a function in a context forked from ctx
+func runFunction(ctx context.Contexter, fn *manifest.Manifest, steps []func(ctx context.Contexter) error) (result render.Result) {
+	result.Name = fn.Name
+
+	child := context.Fork(ctx)
+	if err := setFunction(child, fn, fn.SourcePaths(), 0); err != nil {
+		result.Err = err
+		return result
+	}
+	// results are reported all together
+	child.Set("quiet", true)
+	for _, step := range steps {
+		if err := step(child); err != nil {
+			result.Err = err
+			return result
+		}
+	}
+	if service, ok := child.Get("service").(types.Service); ok {
+		result.Service = service
+	}
+	return result
+}
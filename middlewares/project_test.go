@@ -0,0 +1,50 @@
+package middlewares
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/metrue/fx/context"
+	"github.com/metrue/fx/manifest"
+	"github.com/metrue/fx/types"
+)
+
+func TestProject(t *testing.T) {
+	functions := []*manifest.Manifest{
+		&manifest.Manifest{Name: "fn-1"},
+		&manifest.Manifest{Name: "fn-2"},
+		&manifest.Manifest{Name: "fn-3"},
+	}
+
+	var mux sync.Mutex
+	deployed := map[string]bool{}
+	deploy := func(ctx context.Contexter) error {
+		name := ctx.Get("name").(string)
+		if name == "fn-2" {
+			return fmt.Errorf("build %s failed", name)
+		}
+		mux.Lock()
+		deployed[name] = true
+		mux.Unlock()
+		ctx.Set("service", types.Service{Name: name})
+		return nil
+	}
+
+	ctx := context.NewContext()
+	ctx.Set("functions", functions)
+	ctx.Set("parallel", 2)
+	err := Project(deploy)(ctx)
+	if err == nil {
+		t.Fatalf("should get error when a function failed")
+	}
+	if err.Error() != "1 of 3 functions failed" {
+		t.Fatalf("should get %s but got %s", "1 of 3 functions failed", err)
+	}
+	if !deployed["fn-1"] || !deployed["fn-3"] {
+		t.Fatalf("should deploy the others when a function failed, but got %v", deployed)
+	}
+	if ctx.Get("name") != nil {
+		t.Fatalf("should not set function settings to project context")
+	}
+}
@@ -4,57 +4,114 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/apex/log"
 	"github.com/metrue/fx/config"
 	"github.com/metrue/fx/constants"
 	dockerHTTP "github.com/metrue/fx/container_runtimes/docker/http"
+	"github.com/metrue/fx/container_runtimes/podman"
 	"github.com/metrue/fx/context"
 	"github.com/metrue/fx/infra"
 	dockerInfra "github.com/metrue/fx/infra/docker"
 	k8sInfra "github.com/metrue/fx/infra/k8s"
+	"github.com/metrue/fx/pkg/failure"
 	"github.com/pkg/errors"
 )
 
 // Provision make sure infrastructure is healthy
 func Provision(ctx context.Contexter) (err error) {
+	defer func() {
+		err = failure.Provision(err)
+	}()
+
 	fxConfig := ctx.Get("config").(*config.Config)
 	cloud := fxConfig.Clouds[fxConfig.CurrentCloud]
+	// target infrastructure could be declared in manifest
+	if name, ok := ctx.Get("infra").(string); ok && name != "" {
+		c, ok := fxConfig.Clouds[name]
+		if !ok {
+			return failure.NotFound(fmt.Errorf("no such infrastructure %s, please add it with 'fx infra create' first", name))
+		}
+		cloud = c
+	}
+
+	// namespace given by command line overrides the default one of infrastructure
+	namespace, _ := ctx.Get("namespace").(string)
+	if namespace == "" {
+		namespace = cloud["namespace"]
+	}
 
 	var deployer infra.Deployer
 	if os.Getenv("KUBECONFIG") != "" {
-		deployer, err = k8sInfra.CreateDeployer(os.Getenv("KUBECONFIG"))
+		k8s, err := k8sInfra.CreateDeployer(os.Getenv("KUBECONFIG"), namespace)
 		if err != nil {
 			return err
 		}
+		useBuilder(ctx, k8s, cloud)
+		if include, _ := ctx.Get("include_unlabeled").(bool); include {
+			k8s.IncludeUnlabeled()
+		}
+		deployer = k8s
 		ctx.Set("cloud_type", config.CloudTypeK8S)
 	} else if cloud["type"] == config.CloudTypeDocker {
-		provisioner := dockerInfra.CreateProvisioner(cloud["host"], cloud["user"])
-		ok, err := provisioner.HealthCheck()
-		if err != nil {
-			return err
+		if namespace != "" {
+			log.Warnf("namespace %s is ignored, it's supported by Kubernetes only", namespace)
 		}
-		if !ok {
-			if _, err := provisioner.Provision(); err != nil {
+		provisioner := dockerInfra.CreateProvisioner(cloud["host"], cloud["user"])
+		provisioner.Agent = cloud["agent"] == "true"
+		if cloud["endpoint"] == "" {
+			ok, err := provisioner.HealthCheck()
+			if err != nil {
 				return err
 			}
+			if !ok {
+				if _, err := provisioner.Provision(); err != nil {
+					return err
+				}
+			}
 		}
 
-		docker, err := dockerHTTP.Create(cloud["host"], constants.AgentPort)
+		docker, err := dockerRuntime(provisioner, cloud)
 		if err != nil {
 			return errors.Wrapf(err, "please make sure docker is installed and running on your host")
 		}
 
 		// TODO should clean up, but it needed in middlewares.Build
 		ctx.Set("docker", docker)
+		// images are pushed to registry and deployed by digest when it's configured
+		if cloud["registry"] != "" {
+			ctx.Set("registry", cloud["registry"])
+		}
 		deployer, err = dockerInfra.CreateDeployer(docker)
 		if err != nil {
 			return err
 		}
 		ctx.Set("cloud_type", config.CloudTypeDocker)
+	} else if cloud["type"] == config.CloudTypePodman {
+		if namespace != "" {
+			log.Warnf("namespace %s is ignored, it's supported by Kubernetes only", namespace)
+		}
+		runtime, err := podman.Create(cloud["socket"])
+		if err != nil {
+			return errors.Wrapf(err, "please make sure Podman service is running, e.g. 'podman system service'")
+		}
+
+		// Podman serves as the container runtime of docker deployer
+		ctx.Set("docker", runtime)
+		if cloud["registry"] != "" {
+			ctx.Set("registry", cloud["registry"])
+		}
+		deployer, err = dockerInfra.CreateDeployer(runtime)
+		if err != nil {
+			return err
+		}
+		ctx.Set("cloud_type", config.CloudTypePodman)
 	} else if cloud["type"] == config.CloudTypeK8S {
-		deployer, err = k8sInfra.CreateDeployer(cloud["kubeconfig"])
+		k8s, err := k8sInfra.CreateDeployer(cloud["kubeconfig"], namespace)
 		if err != nil {
 			return err
 		}
+		useBuilder(ctx, k8s, cloud)
+		deployer = k8s
 		ctx.Set("cloud_type", config.CloudTypeK8S)
 	} else {
 		return fmt.Errorf("unsupport cloud type %s, please make sure you config is correct", cloud["type"])
@@ -64,3 +121,40 @@ func Provision(ctx context.Contexter) (err error) {
 
 	return nil
 }
+
+// dockerRuntime connect docker engine of a docker cloud, with its endpoint when it's set, or by the legacy fx-agent,
+// otherwise by the unix socket of docker engine, through a SSH tunnel when host is remote
+func dockerRuntime(provisioner *dockerInfra.Provisioner, cloud map[string]string) (*dockerHTTP.API, error) {
+	if cloud["endpoint"] != "" {
+		var tlsOptions *dockerHTTP.TLSOptions
+		if cloud["tls_cacert"] != "" || cloud["tls_cert"] != "" || cloud["tls_key"] != "" {
+			tlsOptions = &dockerHTTP.TLSOptions{
+				CACert: cloud["tls_cacert"],
+				Cert:   cloud["tls_cert"],
+				Key:    cloud["tls_key"],
+			}
+		}
+		return dockerHTTP.Dial(cloud["endpoint"], tlsOptions)
+	}
+	if provisioner.Agent {
+		return dockerHTTP.Create(cloud["host"], constants.AgentPort)
+	}
+	if provisioner.IsLocalHost() {
+		return dockerHTTP.Dial(dockerHTTP.DefaultEndpoint, nil)
+	}
+	tunnel := infra.NewSSHTunnel(cloud["user"], cloud["host"], infra.DockerSocket)
+	if cloud["insecure_ignore_host_key"] == "true" {
+		tunnel.IgnoreHostKey()
+	}
+	return dockerHTTP.CreateWithDialer(tunnel.DialContext)
+}
+
+// useBuilder build images in cluster when a registry is configured for the infrastructure,
+// otherwise they're built by the legacy init container of deployment
+func useBuilder(ctx context.Contexter, k8s *k8sInfra.K8S, cloud map[string]string) {
+	if cloud["registry"] == "" {
+		return
+	}
+	k8s.SetRegistry(cloud["registry"], cloud["registry_secret"])
+	ctx.Set("builder", k8s)
+}
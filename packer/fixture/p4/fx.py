@@ -0,0 +1,2 @@
+def fx(request):
+    return "hello world"
@@ -0,0 +1,16 @@
+using Microsoft.AspNetCore.Builder;
+using Microsoft.AspNetCore.Hosting;
+using Microsoft.Extensions.Hosting;
+
+public class App
+{
+    public static void Main(string[] args)
+    {
+        Host.CreateDefaultBuilder(args)
+            .ConfigureWebHostDefaults(web => web
+                .UseUrls("http://0.0.0.0:3000")
+                .Configure(app => app.Run(Fx.Handle)))
+            .Build()
+            .Run();
+    }
+}
@@ -0,0 +1,11 @@
+using System.Threading.Tasks;
+using Microsoft.AspNetCore.Http;
+
+public static class Fx
+{
+    // ctx is the HttpContext of ASP.NET Core, e.g. ctx.Request.Body and ctx.Response
+    public static Task Handle(HttpContext ctx)
+    {
+        return ctx.Response.WriteAsync("hello world");
+    }
+}
@@ -0,0 +1,8 @@
+import { serve } from "https://deno.land/std@0.50.0/http/server.ts";
+import fx from "./fx.ts";
+
+for await (const request of serve({ port: 3000 })) {
+  const ctx = { request, body: "" };
+  await fx(ctx);
+  request.respond({ body: ctx.body });
+}
@@ -0,0 +1,7 @@
+import io.javalin.Javalin
+
+fun main() {
+    val app = Javalin.create().start(3000)
+    app.get("/") { ctx -> fx(ctx) }
+    app.post("/") { ctx -> fx(ctx) }
+}
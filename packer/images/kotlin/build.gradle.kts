@@ -0,0 +1,18 @@
+plugins {
+    kotlin("jvm") version "1.3.72"
+    application
+}
+
+repositories {
+    mavenCentral()
+}
+
+dependencies {
+    implementation(kotlin("stdlib"))
+    implementation("io.javalin:javalin:3.8.0")
+    implementation("org.slf4j:slf4j-simple:1.7.30")
+}
+
+application {
+    mainClassName = "AppKt"
+}
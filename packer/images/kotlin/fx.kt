@@ -0,0 +1,6 @@
+import io.javalin.http.Context
+
+// ctx is the Context of Javalin, e.g. ctx.body() and ctx.result()
+fun fx(ctx: Context) {
+    ctx.result("hello world")
+}
@@ -0,0 +1,6 @@
+-- ctx is ngx of OpenResty, e.g. ctx.req.get_body_data() and ctx.say()
+local function fx(ctx)
+  ctx.say("hello world")
+end
+
+return fx
@@ -0,0 +1,10 @@
+use Mojolicious::Lite;
+
+require './fx.pl';
+
+any '/' => sub {
+  my $c = shift;
+  fx($c);
+};
+
+app->start('daemon', '-l', 'http://*:3000');
@@ -0,0 +1,7 @@
+# c is the controller of Mojolicious, e.g. $c->req->json and $c->render
+sub fx {
+  my $c = shift;
+  $c->render(text => 'hello world');
+}
+
+1;
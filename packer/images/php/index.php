@@ -1,4 +1,5 @@
 <?php
+    require(__DIR__ . "/vendor/autoload.php");
     include("fx.php");
     $data = file_get_contents("php://input");
     $res = json_decode($data,true);
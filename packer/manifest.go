@@ -0,0 +1,572 @@
+package packer
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// manifests mergers of dependency manifests keyed by their file names, the one given by user is merged with
+// the one of preset, so that function has dependencies of both, version of user wins when a dependency is in both
+var manifests = map[string]func(preset []byte, user []byte) ([]byte, error){
+	"go.mod":            mergeGoMod,
+	"pom.xml":           mergePom,
+	"REQUIRE":           mergeLines(requirementName),
+	"package.json":      mergeJSON("dependencies"),
+	"composer.json":     mergeJSON("require"),
+	"dub.json":          mergeDubJSON,
+	"cpanfile":          mergeLines(cpanName),
+	"requirements.txt":  mergeLines(requirementName),
+	"Gemfile":           mergeLines(gemName),
+	"Cargo.toml":        mergeCargoToml,
+	"app.csproj":        mergeCsproj,
+	"build.gradle.kts":  mergeGradleKts,
+	"fx-dev-1.rockspec": mergeRockspec,
+	"deps.ts":           mergeLines(denoName),
+}
+
+var (
+	requirementPattern    = regexp.MustCompile(`^\s*([A-Za-z0-9][A-Za-z0-9._-]*)`)
+	gemPattern            = regexp.MustCompile(`^\s*gem\s+['"]([^'"]+)['"]`)
+	cpanPattern           = regexp.MustCompile(`^\s*requires\s+['"]([^'"]+)['"]`)
+	goRequirePattern      = regexp.MustCompile(`^\s*(?:require\s+)?([^\s()]+)\s+(v[^\s]+)`)
+	pomDependencyPattern  = regexp.MustCompile(`(?s)<dependency>.*?</dependency>`)
+	pomGroupPattern       = regexp.MustCompile(`<groupId>\s*([^<\s]+)\s*</groupId>`)
+	pomArtifactPattern    = regexp.MustCompile(`<artifactId>\s*([^<\s]+)\s*</artifactId>`)
+	cargoKeyPattern       = regexp.MustCompile(`^\s*([A-Za-z0-9_-]+)\s*=`)
+	packageRefPattern     = regexp.MustCompile(`(?s)<PackageReference\b[^>]*?(?:/>|>.*?</PackageReference>)`)
+	packageIncludePattern = regexp.MustCompile(`Include="([^"]+)"`)
+	gradleDepPattern      = regexp.MustCompile(`^\s*(\w+)\(\s*(?:kotlin\("([^"]+)"|"([^":]+:[^":]+))`)
+	rockDepsPattern       = regexp.MustCompile(`(?s)(dependencies\s*=\s*\{)(.*?)(\})`)
+	rockItemPattern       = regexp.MustCompile(`"([^"]*)"|'([^']*)'`)
+	denoImportPattern     = regexp.MustCompile(`from\s+["']([^"']+)["']`)
+	denoVersionPattern    = regexp.MustCompile(`@[^/]+`)
+)
+
+// mergeManifests merge dependency manifests in output, which are copied from user's sources over the ones
+// in templates of language
+func mergeManifests(output string, templates map[string][]byte) error {
+	for name, merge := range manifests {
+		preset, ok := templates[name]
+		if !ok {
+			continue
+		}
+		path := filepath.Join(output, name)
+		user, err := ioutil.ReadFile(path)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return err
+		}
+		if bytes.Equal(preset, user) {
+			continue
+		}
+		merged, err := merge(preset, user)
+		if err != nil {
+			return fmt.Errorf("could not merge %s with the preset one: %s", name, err)
+		}
+		if err := ioutil.WriteFile(path, merged, 0644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// mergeLines merge manifests of one dependency a line, e.g. requirements.txt, lines of preset are kept in order
+// unless user has one of the same dependency, the others of user are appended
+func mergeLines(nameOf func(line string) string) func(preset []byte, user []byte) ([]byte, error) {
+	return func(preset []byte, user []byte) ([]byte, error) {
+		userLines := map[string]string{}
+		for _, line := range lines(user) {
+			if name := nameOf(line); name != "" {
+				userLines[name] = line
+			}
+		}
+
+		merged := []string{}
+		seen := map[string]bool{}
+		for _, line := range lines(preset) {
+			name := nameOf(line)
+			if l, ok := userLines[name]; ok && name != "" {
+				line = l
+			}
+			seen[name] = name != ""
+			merged = append(merged, line)
+		}
+		for _, line := range lines(user) {
+			name := nameOf(line)
+			if name == "" && strings.TrimSpace(line) == "" {
+				continue
+			}
+			if !seen[name] || name == "" {
+				merged = append(merged, line)
+			}
+		}
+		return []byte(strings.Join(merged, "\n") + "\n"), nil
+	}
+}
+
+func lines(body []byte) []string {
+	return strings.Split(strings.TrimRight(strings.Replace(string(body), "\r\n", "\n", -1), "\n"), "\n")
+}
+
+// requirementName name of dependency in a line of requirements.txt or REQUIRE, e.g. 'flask' of 'Flask>=1.0',
+// empty for comments and options
+func requirementName(line string) string {
+	m := requirementPattern.FindStringSubmatch(line)
+	if m == nil {
+		return ""
+	}
+	return strings.ToLower(strings.Replace(m[1], "_", "-", -1))
+}
+
+// gemName name of gem in Gemfile, e.g. 'sinatra' of "gem 'sinatra', '~> 2.0'", a source line is named with itself
+// so that it's not duplicated
+func gemName(line string) string {
+	if m := gemPattern.FindStringSubmatch(line); m != nil {
+		return "gem " + m[1]
+	}
+	if strings.HasPrefix(strings.TrimSpace(line), "source ") {
+		return strings.TrimSpace(line)
+	}
+	return ""
+}
+
+// cpanName name of module in cpanfile, e.g. 'Mojolicious' of "requires 'Mojolicious', '>= 8.0';"
+func cpanName(line string) string {
+	if m := cpanPattern.FindStringSubmatch(line); m != nil {
+		return m[1]
+	}
+	return ""
+}
+
+// mergeJSON merge JSON manifests with dependencies in an object of key, e.g. 'dependencies' of package.json,
+// dependencies of preset are added into the manifest of user
+func mergeJSON(key string) func(preset []byte, user []byte) ([]byte, error) {
+	return func(preset []byte, user []byte) ([]byte, error) {
+		var p, u map[string]interface{}
+		if err := json.Unmarshal(preset, &p); err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(user, &u); err != nil {
+			return nil, err
+		}
+		u[key] = mergeDependencies(u[key], p[key])
+		return marshalJSON(u)
+	}
+}
+
+// mergeDubJSON add dependencies of user into dub.json of preset, which builds the server to run
+func mergeDubJSON(preset []byte, user []byte) ([]byte, error) {
+	var p, u map[string]interface{}
+	if err := json.Unmarshal(preset, &p); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(user, &u); err != nil {
+		return nil, err
+	}
+	p["dependencies"] = mergeDependencies(u["dependencies"], p["dependencies"])
+	return marshalJSON(p)
+}
+
+// mergeDependencies add dependencies of base into the ones of top, the ones of top win
+func mergeDependencies(top interface{}, base interface{}) map[string]interface{} {
+	merged, _ := top.(map[string]interface{})
+	if merged == nil {
+		merged = map[string]interface{}{}
+	}
+	deps, _ := base.(map[string]interface{})
+	for name, version := range deps {
+		if _, ok := merged[name]; !ok {
+			merged[name] = version
+		}
+	}
+	return merged
+}
+
+func marshalJSON(v interface{}) ([]byte, error) {
+	body, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(body, '\n'), nil
+}
+
+// mergeGoMod add requirements of preset into go.mod of user, module path of user is kept for its own packages
+func mergeGoMod(preset []byte, user []byte) ([]byte, error) {
+	required := map[string]bool{}
+	for _, path := range goRequires(user) {
+		required[path[0]] = true
+	}
+	missing := []string{}
+	for _, path := range goRequires(preset) {
+		if !required[path[0]] {
+			missing = append(missing, fmt.Sprintf("\t%s %s", path[0], path[1]))
+		}
+	}
+	if len(missing) == 0 {
+		return user, nil
+	}
+	merged := strings.TrimRight(string(user), "\n") + "\n\nrequire (\n" + strings.Join(missing, "\n") + "\n)\n"
+	return []byte(merged), nil
+}
+
+// goRequires path and version of modules required in go.mod, in both single line and block form
+func goRequires(body []byte) [][2]string {
+	requires := [][2]string{}
+	inBlock := false
+	for _, line := range lines(body) {
+		line = strings.TrimSpace(strings.SplitN(line, "//", 2)[0])
+		switch {
+		case line == "require (":
+			inBlock = true
+			continue
+		case inBlock && line == ")":
+			inBlock = false
+			continue
+		case !inBlock && !strings.HasPrefix(line, "require "):
+			continue
+		}
+		if m := goRequirePattern.FindStringSubmatch(line); m != nil {
+			requires = append(requires, [2]string{m[1], m[2]})
+		}
+	}
+	return requires
+}
+
+// mergePom add dependencies of user into pom.xml of preset, which builds the jar to run
+func mergePom(preset []byte, user []byte) ([]byte, error) {
+	end := bytes.LastIndex(preset, []byte("</dependencies>"))
+	if end < 0 {
+		return nil, fmt.Errorf("no dependencies in pom.xml of preset")
+	}
+	userDeps := map[string][]byte{}
+	names := []string{}
+	for _, dep := range pomDependencyPattern.FindAll(user, -1) {
+		name := pomDependencyName(dep)
+		if _, ok := userDeps[name]; !ok {
+			names = append(names, name)
+		}
+		userDeps[name] = dep
+	}
+
+	// dependencies of preset are replaced by the ones of user with the same groupId and artifactId
+	merged := pomDependencyPattern.ReplaceAllFunc(preset[:end], func(dep []byte) []byte {
+		name := pomDependencyName(dep)
+		if d, ok := userDeps[name]; ok {
+			delete(userDeps, name)
+			return d
+		}
+		return dep
+	})
+	var buf bytes.Buffer
+	buf.Write(merged)
+	for _, name := range names {
+		if dep, ok := userDeps[name]; ok {
+			buf.Write(dep)
+			buf.WriteString("\n  ")
+		}
+	}
+	buf.Write(preset[end:])
+	return buf.Bytes(), nil
+}
+
+func pomDependencyName(dep []byte) string {
+	var group, artifact string
+	if m := pomGroupPattern.FindSubmatch(dep); m != nil {
+		group = string(m[1])
+	}
+	if m := pomArtifactPattern.FindSubmatch(dep); m != nil {
+		artifact = string(m[1])
+	}
+	return group + ":" + artifact
+}
+
+// mergeCargoToml add dependencies of user into Cargo.toml of preset, which builds the server to run,
+// tables of user other than [package] are appended unless preset has the same one
+func mergeCargoToml(preset []byte, user []byte) ([]byte, error) {
+	presetTables := tomlTables(preset)
+	userTables := tomlTables(user)
+
+	userDeps := map[string]string{}
+	depNames := []string{}
+	for _, line := range userTables.lines["[dependencies]"] {
+		if m := cargoKeyPattern.FindStringSubmatch(line); m != nil {
+			userDeps[m[1]] = line
+			depNames = append(depNames, m[1])
+		}
+	}
+
+	merged := []string{}
+	for _, table := range presetTables.order {
+		if table != "" {
+			merged = append(merged, table)
+		}
+		for _, line := range presetTables.lines[table] {
+			if m := cargoKeyPattern.FindStringSubmatch(line); m != nil && table == "[dependencies]" {
+				if l, ok := userDeps[m[1]]; ok {
+					line = l
+					delete(userDeps, m[1])
+				}
+			}
+			merged = append(merged, line)
+		}
+		if table == "[dependencies]" {
+			merged = trimBlankLines(merged)
+			for _, name := range depNames {
+				if line, ok := userDeps[name]; ok {
+					merged = append(merged, line)
+				}
+			}
+			merged = append(merged, "")
+		}
+	}
+	extra := []string{}
+	for _, table := range userTables.order {
+		if _, ok := presetTables.lines[table]; ok || table == "" || table == "[package]" {
+			continue
+		}
+		extra = append(extra, table)
+	}
+	sort.Strings(extra)
+	for _, table := range extra {
+		merged = append(trimBlankLines(merged), "", table)
+		merged = append(merged, userTables.lines[table]...)
+	}
+	return []byte(strings.Join(trimBlankLines(merged), "\n") + "\n"), nil
+}
+
+type toml struct {
+	order []string
+	lines map[string][]string
+}
+
+// tomlTables lines of TOML grouped by their tables, lines before the first table are in table ""
+func tomlTables(body []byte) toml {
+	t := toml{lines: map[string][]string{}}
+	table := ""
+	t.order = append(t.order, table)
+	for _, line := range lines(body) {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "[") && strings.HasSuffix(trimmed, "]") {
+			table = trimmed
+			if _, ok := t.lines[table]; !ok {
+				t.order = append(t.order, table)
+			}
+			continue
+		}
+		t.lines[table] = append(t.lines[table], line)
+	}
+	return t
+}
+
+func trimBlankLines(lines []string) []string {
+	for len(lines) > 0 && strings.TrimSpace(lines[len(lines)-1]) == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+// mergeCsproj add package references of user into app.csproj of preset, which builds the server to run,
+// a package referenced by both is in the version of user
+func mergeCsproj(preset []byte, user []byte) ([]byte, error) {
+	end := bytes.LastIndex(preset, []byte("</Project>"))
+	if end < 0 {
+		return nil, fmt.Errorf("no Project in app.csproj of preset")
+	}
+	userRefs := map[string][]byte{}
+	names := []string{}
+	for _, ref := range packageRefPattern.FindAll(user, -1) {
+		m := packageIncludePattern.FindSubmatch(ref)
+		if m == nil {
+			continue
+		}
+		name := strings.ToLower(string(m[1]))
+		if _, ok := userRefs[name]; !ok {
+			names = append(names, name)
+		}
+		userRefs[name] = ref
+	}
+
+	merged := packageRefPattern.ReplaceAllFunc(preset[:end], func(ref []byte) []byte {
+		if m := packageIncludePattern.FindSubmatch(ref); m != nil {
+			name := strings.ToLower(string(m[1]))
+			if r, ok := userRefs[name]; ok {
+				delete(userRefs, name)
+				return r
+			}
+		}
+		return ref
+	})
+	var buf bytes.Buffer
+	buf.Write(merged)
+	added := false
+	for _, name := range names {
+		if ref, ok := userRefs[name]; ok {
+			if !added {
+				buf.WriteString("  <ItemGroup>\n")
+				added = true
+			}
+			buf.WriteString("    ")
+			buf.Write(ref)
+			buf.WriteString("\n")
+		}
+	}
+	if added {
+		buf.WriteString("  </ItemGroup>\n\n")
+	}
+	buf.Write(preset[end:])
+	return buf.Bytes(), nil
+}
+
+// mergeGradleKts add dependencies of user into build.gradle.kts of preset, which builds the server to run,
+// a dependency is named with its configuration and module, e.g. 'implementation io.javalin:javalin'
+func mergeGradleKts(preset []byte, user []byte) ([]byte, error) {
+	presetLines := lines(preset)
+	start, end := gradleDependencies(presetLines)
+	if start < 0 {
+		return nil, fmt.Errorf("no dependencies in build.gradle.kts of preset")
+	}
+	userLines := lines(user)
+	userStart, userEnd := gradleDependencies(userLines)
+	if userStart < 0 {
+		return preset, nil
+	}
+
+	userDeps := map[string]string{}
+	extra := []string{}
+	for _, line := range userLines[userStart+1 : userEnd] {
+		if name := gradleDependencyName(line); name != "" {
+			if _, ok := userDeps[name]; !ok {
+				extra = append(extra, name)
+			}
+			userDeps[name] = line
+		} else if strings.TrimSpace(line) != "" {
+			extra = append(extra, line)
+		}
+	}
+
+	deps := []string{}
+	seen := map[string]bool{}
+	for _, line := range presetLines[start+1 : end] {
+		name := gradleDependencyName(line)
+		if l, ok := userDeps[name]; ok && name != "" {
+			line = l
+		}
+		seen[name] = name != ""
+		seen[strings.TrimSpace(line)] = true
+		deps = append(deps, line)
+	}
+	for _, item := range extra {
+		if line, ok := userDeps[item]; ok {
+			if !seen[item] {
+				deps = append(deps, line)
+			}
+		} else if !seen[strings.TrimSpace(item)] {
+			deps = append(deps, item)
+		}
+	}
+
+	merged := append(append(append([]string{}, presetLines[:start+1]...), deps...), presetLines[end:]...)
+	return []byte(strings.Join(merged, "\n") + "\n"), nil
+}
+
+// gradleDependencies lines of the opening and closing brace of top level dependencies block, -1 when there is none
+func gradleDependencies(lines []string) (int, int) {
+	for i, line := range lines {
+		if strings.TrimSpace(line) != "dependencies {" || strings.HasPrefix(line, " ") {
+			continue
+		}
+		depth := 0
+		for j := i; j < len(lines); j++ {
+			depth += strings.Count(lines[j], "{") - strings.Count(lines[j], "}")
+			if depth == 0 {
+				return i, j
+			}
+		}
+	}
+	return -1, -1
+}
+
+func gradleDependencyName(line string) string {
+	m := gradleDepPattern.FindStringSubmatch(line)
+	if m == nil {
+		return ""
+	}
+	if m[2] != "" {
+		return m[1] + " org.jetbrains.kotlin:kotlin-" + m[2]
+	}
+	return m[1] + " " + m[3]
+}
+
+// mergeRockspec add dependencies of user into the rockspec of preset, a dependency is named with the first
+// word of it, e.g. 'lua-cjson' of 'lua-cjson >= 2.1'
+func mergeRockspec(preset []byte, user []byte) ([]byte, error) {
+	if !rockDepsPattern.Match(preset) {
+		return nil, fmt.Errorf("no dependencies in rockspec of preset")
+	}
+	m := rockDepsPattern.FindSubmatch(user)
+	if m == nil {
+		return preset, nil
+	}
+	userDeps := rockDependencies(m[2])
+
+	return rockDepsPattern.ReplaceAllFunc(preset, func(table []byte) []byte {
+		parts := rockDepsPattern.FindSubmatch(table)
+		deps := []string{}
+		seen := map[string]bool{}
+		for _, dep := range rockDependencies(parts[2]) {
+			name := strings.Fields(dep)[0]
+			for _, d := range userDeps {
+				if strings.Fields(d)[0] == name {
+					dep = d
+				}
+			}
+			seen[name] = true
+			deps = append(deps, dep)
+		}
+		for _, dep := range userDeps {
+			if name := strings.Fields(dep)[0]; !seen[name] {
+				seen[name] = true
+				deps = append(deps, dep)
+			}
+		}
+		items := []string{}
+		for _, dep := range deps {
+			items = append(items, fmt.Sprintf("  %q", dep))
+		}
+		return []byte(string(parts[1]) + "\n" + strings.Join(items, ",\n") + "\n" + string(parts[3]))
+	}), nil
+}
+
+func rockDependencies(table []byte) []string {
+	deps := []string{}
+	for _, m := range rockItemPattern.FindAllSubmatch(table, -1) {
+		dep := strings.TrimSpace(string(m[1]) + string(m[2]))
+		if dep != "" {
+			deps = append(deps, dep)
+		}
+	}
+	return deps
+}
+
+// denoName name of module exported in deps.ts, it's the URL of module without version, e.g.
+// 'https://deno.land/std/path/mod.ts' of 'https://deno.land/std@0.50.0/path/mod.ts'. Other lines are named
+// with themselves so that they're not duplicated
+func denoName(line string) string {
+	trimmed := strings.TrimSpace(line)
+	if !strings.HasPrefix(trimmed, "export") && !strings.HasPrefix(trimmed, "import") {
+		return trimmed
+	}
+	if m := denoImportPattern.FindStringSubmatch(line); m != nil {
+		return "from " + denoVersionPattern.ReplaceAllString(m[1], "")
+	}
+	return trimmed
+}
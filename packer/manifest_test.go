@@ -0,0 +1,289 @@
+package packer
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestMergeManifests(t *testing.T) {
+	t.Run("requirements.txt", func(t *testing.T) {
+		merged, err := mergeLines(requirementName)([]byte("flask\ngunicorn==19.9\n"), []byte("# http\nrequests==2.22.0\nFlask>=1.1\n"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		expect := "Flask>=1.1\ngunicorn==19.9\n# http\nrequests==2.22.0\n"
+		if string(merged) != expect {
+			t.Fatalf("should get %q but got %q", expect, merged)
+		}
+	})
+
+	t.Run("Gemfile", func(t *testing.T) {
+		merged, err := mergeLines(gemName)([]byte("source 'https://rubygems.org'\n\ngem 'sinatra'\n"), []byte("source 'https://rubygems.org'\ngem 'sinatra', '~> 2.0'\ngem \"rack-cors\"\n"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		expect := "source 'https://rubygems.org'\n\ngem 'sinatra', '~> 2.0'\ngem \"rack-cors\"\n"
+		if string(merged) != expect {
+			t.Fatalf("should get %q but got %q", expect, merged)
+		}
+	})
+
+	t.Run("cpanfile", func(t *testing.T) {
+		merged, err := mergeLines(cpanName)([]byte("requires 'Mojolicious';\n"), []byte("requires 'Mojolicious', '>= 8.0';\nrequires \"DBI\";\n"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		expect := "requires 'Mojolicious', '>= 8.0';\nrequires \"DBI\";\n"
+		if string(merged) != expect {
+			t.Fatalf("should get %q but got %q", expect, merged)
+		}
+	})
+
+	t.Run("package.json", func(t *testing.T) {
+		merged, err := mergeJSON("dependencies")(
+			[]byte(`{"name":"fx","dependencies":{"koa":"^2.11.0","koa-bodyparser":"^4.2.1"}}`),
+			[]byte(`{"name":"my-func","dependencies":{"koa":"^2.0.0","lodash":"^4.17.15"},"devDependencies":{"mocha":"^7.0.0"}}`),
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var pkg map[string]interface{}
+		if err := json.Unmarshal(merged, &pkg); err != nil {
+			t.Fatal(err)
+		}
+		expect := map[string]interface{}{"koa": "^2.0.0", "koa-bodyparser": "^4.2.1", "lodash": "^4.17.15"}
+		if pkg["name"] != "my-func" || !reflect.DeepEqual(pkg["dependencies"], expect) || pkg["devDependencies"] == nil {
+			t.Fatalf("should get dependencies of both but got %s", merged)
+		}
+	})
+
+	t.Run("composer.json", func(t *testing.T) {
+		merged, err := mergeJSON("require")(
+			[]byte(`{"name":"fx/fx","require":{"php":">=7.0"}}`),
+			[]byte(`{"name":"fx/my-func","require":{"php":">=7.2","guzzlehttp/guzzle":"^6.5"}}`),
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var composer map[string]interface{}
+		if err := json.Unmarshal(merged, &composer); err != nil {
+			t.Fatal(err)
+		}
+		expect := map[string]interface{}{"php": ">=7.2", "guzzlehttp/guzzle": "^6.5"}
+		if composer["name"] != "fx/my-func" || !reflect.DeepEqual(composer["require"], expect) {
+			t.Fatalf("should get requirements of both but got %s", merged)
+		}
+	})
+
+	t.Run("dub.json", func(t *testing.T) {
+		preset, err := presets.Find("d/dub.json")
+		if err != nil {
+			t.Fatal(err)
+		}
+		merged, err := mergeDubJSON(preset, []byte(`{"name":"my-func","dependencies":{"vibe-d:data":"~>0.8.6"}}`))
+		if err != nil {
+			t.Fatal(err)
+		}
+		var dub map[string]interface{}
+		if err := json.Unmarshal(merged, &dub); err != nil {
+			t.Fatal(err)
+		}
+		expect := map[string]interface{}{"vibe-d:data": "~>0.8.6"}
+		if dub["name"] != "fx" || dub["mainSourceFile"] != "app.d" || !reflect.DeepEqual(dub["dependencies"], expect) {
+			t.Fatalf("should keep build of preset with dependencies of user but got %s", merged)
+		}
+	})
+
+	t.Run("go.mod", func(t *testing.T) {
+		merged, err := mergeGoMod(
+			[]byte("module fx\n\ngo 1.12\n\nrequire github.com/gin-gonic/gin v1.5.0\n"),
+			[]byte("module my-func\n\ngo 1.13\n\nrequire (\n\tgithub.com/google/uuid v1.1.1\n\tgithub.com/pkg/errors v0.8.1 // indirect\n)\n"),
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+		expect := "module my-func\n\ngo 1.13\n\nrequire (\n\tgithub.com/google/uuid v1.1.1\n\tgithub.com/pkg/errors v0.8.1 // indirect\n)\n\nrequire (\n\tgithub.com/gin-gonic/gin v1.5.0\n)\n"
+		if string(merged) != expect {
+			t.Fatalf("should get %q but got %q", expect, merged)
+		}
+
+		user := []byte("module my-func\n\nrequire github.com/gin-gonic/gin v1.6.0\n")
+		merged, err = mergeGoMod([]byte("module fx\n\nrequire github.com/gin-gonic/gin v1.5.0\n"), user)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(merged) != string(user) {
+			t.Fatalf("should get %q but got %q", user, merged)
+		}
+	})
+
+	t.Run("pom.xml", func(t *testing.T) {
+		preset, err := presets.Find("java/pom.xml")
+		if err != nil {
+			t.Fatal(err)
+		}
+		user := []byte(`<project><dependencies>
+  <dependency><groupId>org.json</groupId><artifactId>json</artifactId><version>20190722</version></dependency>
+  <dependency><groupId>com.google.guava</groupId><artifactId>guava</artifactId><version>28.1-jre</version></dependency>
+</dependencies></project>`)
+		merged, err := mergePom(preset, user)
+		if err != nil {
+			t.Fatal(err)
+		}
+		deps := pomDependencyPattern.FindAll(merged, -1)
+		names := []string{}
+		for _, dep := range deps {
+			names = append(names, pomDependencyName(dep))
+		}
+		expect := []string{"io.javalin:javalin", "org.json:json", "com.google.guava:guava"}
+		if !reflect.DeepEqual(names, expect) {
+			t.Fatalf("should get %v but got %v", expect, names)
+		}
+		if !strings.Contains(string(merged), "20190722") || strings.Contains(string(merged), "20171018") {
+			t.Fatalf("should get version of user but got %s", merged)
+		}
+		if !strings.Contains(string(merged), "maven-shade-plugin") {
+			t.Fatalf("should keep build of preset but got %s", merged)
+		}
+	})
+
+	t.Run("Cargo.toml", func(t *testing.T) {
+		preset := []byte("[package]\nname = \"rust\"\n\n[dependencies]\nrocket = \"0.4.0-rc.2\"\nserde = \"1.0.70\"\n")
+		user := []byte("[package]\nname = \"my-func\"\n\n[dependencies]\nserde = { version = \"1.0\", features = [\"derive\"] }\nrand = \"0.7\"\n\n[dev-dependencies]\nquickcheck = \"0.9\"\n")
+		merged, err := mergeCargoToml(preset, user)
+		if err != nil {
+			t.Fatal(err)
+		}
+		expect := "[package]\nname = \"rust\"\n\n[dependencies]\nrocket = \"0.4.0-rc.2\"\nserde = { version = \"1.0\", features = [\"derive\"] }\nrand = \"0.7\"\n\n[dev-dependencies]\nquickcheck = \"0.9\"\n"
+		if string(merged) != expect {
+			t.Fatalf("should get %q but got %q", expect, merged)
+		}
+	})
+
+	t.Run("app.csproj", func(t *testing.T) {
+		preset, err := presets.Find("csharp/app.csproj")
+		if err != nil {
+			t.Fatal(err)
+		}
+		user := []byte(`<Project Sdk="Microsoft.NET.Sdk.Web">
+  <ItemGroup>
+    <PackageReference Include="Newtonsoft.Json" Version="12.0.3" />
+    <PackageReference Include="Dapper" Version="2.0.35" />
+  </ItemGroup>
+</Project>`)
+		merged, err := mergeCsproj(preset, user)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !strings.Contains(string(merged), `<AssemblyName>fx</AssemblyName>`) {
+			t.Fatalf("should keep build of preset but got %s", merged)
+		}
+		if len(packageRefPattern.FindAll(merged, -1)) != 2 || !strings.Contains(string(merged), `Include="Dapper" Version="2.0.35"`) {
+			t.Fatalf("should add package references of user but got %s", merged)
+		}
+
+		again, err := mergeCsproj(merged, []byte(`<PackageReference Include="dapper" Version="2.0.78" />`))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(packageRefPattern.FindAll(again, -1)) != 2 || !strings.Contains(string(again), `Version="2.0.78"`) {
+			t.Fatalf("should get version of user but got %s", again)
+		}
+	})
+
+	t.Run("build.gradle.kts", func(t *testing.T) {
+		preset, err := presets.Find("kotlin/build.gradle.kts")
+		if err != nil {
+			t.Fatal(err)
+		}
+		user := []byte(`plugins {
+    kotlin("jvm") version "1.3.72"
+}
+
+dependencies {
+    implementation(kotlin("stdlib"))
+    implementation("io.javalin:javalin:3.9.1")
+    implementation("com.squareup.okhttp3:okhttp:4.7.2")
+    testImplementation(kotlin("test-junit"))
+}
+`)
+		merged, err := mergeGradleKts(preset, user)
+		if err != nil {
+			t.Fatal(err)
+		}
+		expect := `dependencies {
+    implementation(kotlin("stdlib"))
+    implementation("io.javalin:javalin:3.9.1")
+    implementation("org.slf4j:slf4j-simple:1.7.30")
+    implementation("com.squareup.okhttp3:okhttp:4.7.2")
+    testImplementation(kotlin("test-junit"))
+}`
+		if !strings.Contains(string(merged), expect) || !strings.Contains(string(merged), `mainClassName = "AppKt"`) {
+			t.Fatalf("should get %s in %s", expect, merged)
+		}
+	})
+
+	t.Run("rockspec", func(t *testing.T) {
+		preset, err := presets.Find("lua/fx-dev-1.rockspec")
+		if err != nil {
+			t.Fatal(err)
+		}
+		user := []byte("package = \"my-func\"\ndependencies = {\n  \"lua >= 5.3\",\n  'lua-cjson >= 2.1'\n}\n")
+		merged, err := mergeRockspec(preset, user)
+		if err != nil {
+			t.Fatal(err)
+		}
+		expect := "dependencies = {\n  \"lua >= 5.3\",\n  \"lua-cjson >= 2.1\"\n}"
+		if !strings.Contains(string(merged), expect) || !strings.Contains(string(merged), `package = "fx"`) {
+			t.Fatalf("should get %q in %s", expect, merged)
+		}
+	})
+
+	t.Run("deps.ts", func(t *testing.T) {
+		preset, err := presets.Find("deno/deps.ts")
+		if err != nil {
+			t.Fatal(err)
+		}
+		user := []byte("export * as path from \"https://deno.land/std@0.51.0/path/mod.ts\";\nexport {};\n")
+		merged, err := mergeLines(denoName)(preset, user)
+		if err != nil {
+			t.Fatal(err)
+		}
+		expect := string(preset) + "export * as path from \"https://deno.land/std@0.51.0/path/mod.ts\";\n"
+		if string(merged) != expect {
+			t.Fatalf("should get %q but got %q", expect, merged)
+		}
+	})
+}
+
+func TestPackWithManifest(t *testing.T) {
+	output, err := ioutil.TempDir("", "fx-manifest")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(output)
+
+	if err := Pack(output, "./fixture/p4"); err != nil {
+		t.Fatal(err)
+	}
+	body, err := ioutil.ReadFile(filepath.Join(output, "requirements.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect := "Flask>=1.1\nrequests==2.22.0\n"
+	if string(body) != expect {
+		t.Fatalf("should get %q but got %q", expect, body)
+	}
+	dockerfile, err := ioutil.ReadFile(filepath.Join(output, "Dockerfile"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(dockerfile), "pip install -r requirements.txt") {
+		t.Fatalf("should install requirements in Dockerfile but got %s", dockerfile)
+	}
+}
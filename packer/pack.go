@@ -0,0 +1,202 @@
+package packer
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/apex/log"
+	"github.com/metrue/fx/utils"
+	"github.com/mitchellh/go-homedir"
+	"gopkg.in/yaml.v3"
+)
+
+// DescriptorFile file name of the descriptor of a user-defined pack
+const DescriptorFile = "pack.yml"
+
+// Descriptor describes a user-defined pack in ~/.fx/packs/<name>/pack.yml, the name of its directory
+// is the language of it, e.g.
+//
+//	extensions:      # sources with these extensions are packed with it
+//	  - .py
+//	handler: fx.py   # pattern of handler file name, e.g. 'fx.*'
+//	templates:       # files and directories of the Docker project, relative to the pack
+//	  - Dockerfile
+//	  - app.py
+//	  - fx.py
+//
+// a user-defined pack is checked before the built-in ones, so it could replace a built-in language
+type Descriptor struct {
+	Extensions []string `yaml:"extensions"`
+	Handler    string   `yaml:"handler"`
+	Templates  []string `yaml:"templates"`
+}
+
+// userPack a user-defined pack loaded from disk
+type userPack struct {
+	name string
+	dir  string
+	Descriptor
+}
+
+// PacksDir directory of user-defined packs, ~/.fx/packs, or packs in the directory of $FX_CONFIG when it's set
+func PacksDir() (string, error) {
+	dir, err := homedir.Expand("~/.fx")
+	if err != nil {
+		return "", err
+	}
+	if os.Getenv("FX_CONFIG") != "" {
+		dir = filepath.Dir(os.Getenv("FX_CONFIG"))
+	}
+	return filepath.Join(dir, "packs"), nil
+}
+
+// loadPacks user-defined packs in PacksDir keyed by their names, a directory without descriptor is not a pack.
+// Invalid packs are not loaded, errors of them are returned keyed by their names, so that they don't fail
+// packing of other languages
+func loadPacks() (map[string]*userPack, map[string]error, error) {
+	packs := map[string]*userPack{}
+	invalid := map[string]error{}
+	dir, err := PacksDir()
+	if err != nil {
+		return nil, nil, err
+	}
+	entries, err := ioutil.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return packs, invalid, nil
+		}
+		return nil, nil, err
+	}
+
+	for _, entry := range entries {
+		if !entry.IsDir() || !utils.IsRegularFile(filepath.Join(dir, entry.Name(), DescriptorFile)) {
+			continue
+		}
+		pack, err := loadPack(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			invalid[entry.Name()] = err
+			continue
+		}
+		packs[pack.name] = pack
+	}
+	return packs, invalid, nil
+}
+
+// checkPacks error of the pack of language when it's invalid, other invalid packs are only warned
+func checkPacks(lang string, invalid map[string]error) error {
+	if err, ok := invalid[lang]; ok {
+		return err
+	}
+	names := []string{}
+	for name := range invalid {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		log.Warnf("%v, it's ignored", invalid[name])
+	}
+	return nil
+}
+
+func loadPack(dir string) (*userPack, error) {
+	name := filepath.Base(dir)
+	body, err := ioutil.ReadFile(filepath.Join(dir, DescriptorFile))
+	if err != nil {
+		return nil, err
+	}
+	var descriptor Descriptor
+	if err := yaml.Unmarshal(body, &descriptor); err != nil {
+		return nil, fmt.Errorf("invalid pack %s: %s", name, err)
+	}
+	pack := &userPack{name: name, dir: dir, Descriptor: descriptor}
+	if err := pack.validate(); err != nil {
+		return nil, fmt.Errorf("invalid pack %s in %s: %s", name, dir, err)
+	}
+	return pack, nil
+}
+
+func (p *userPack) validate() error {
+	if len(p.Extensions) == 0 {
+		return fmt.Errorf("extensions required")
+	}
+	for _, ext := range p.Extensions {
+		if !strings.HasPrefix(ext, ".") {
+			return fmt.Errorf("extension %s should start with '.'", ext)
+		}
+	}
+	if p.Handler == "" {
+		return fmt.Errorf("handler required")
+	}
+	if _, err := filepath.Match(p.Handler, ""); err != nil {
+		return fmt.Errorf("handler %s: %s", p.Handler, err)
+	}
+	if len(p.Templates) == 0 {
+		return fmt.Errorf("templates required")
+	}
+	files, err := p.files()
+	if err != nil {
+		return err
+	}
+	if _, ok := files["Dockerfile"]; !ok {
+		return fmt.Errorf("Dockerfile required in templates")
+	}
+	for path := range files {
+		if p.isHandler(path) {
+			return nil
+		}
+	}
+	return fmt.Errorf("no handler %s in templates", p.Handler)
+}
+
+// claims tell if a source file is in the language of pack by its extension
+func (p *userPack) claims(fileName string) bool {
+	ext := filepath.Ext(fileName)
+	for _, e := range p.Extensions {
+		if ext == e {
+			return true
+		}
+	}
+	return false
+}
+
+// isHandler tell if a file is the handler of pack by its base name
+func (p *userPack) isHandler(name string) bool {
+	ok, _ := filepath.Match(p.Handler, filepath.Base(name))
+	return ok
+}
+
+// files template files of pack keyed by their slash separated paths relative to the pack
+func (p *userPack) files() (map[string][]byte, error) {
+	files := map[string][]byte{}
+	for _, template := range p.Templates {
+		root := filepath.Join(p.dir, filepath.FromSlash(template))
+		if rel, err := filepath.Rel(p.dir, root); err != nil || rel == "." || strings.HasPrefix(rel, "..") {
+			return nil, fmt.Errorf("template %s should be in the pack", template)
+		}
+		if err := filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+			if !info.Mode().IsRegular() {
+				return nil
+			}
+			rel, err := filepath.Rel(p.dir, path)
+			if err != nil {
+				return err
+			}
+			body, err := ioutil.ReadFile(path)
+			if err != nil {
+				return err
+			}
+			files[filepath.ToSlash(rel)] = body
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+	}
+	return files, nil
+}
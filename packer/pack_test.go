@@ -0,0 +1,219 @@
+package packer
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestMain keep packs of developer in ~/.fx/packs away from tests
+func TestMain(m *testing.M) {
+	dir, err := ioutil.TempDir("", "fx-packer")
+	if err != nil {
+		panic(err)
+	}
+	os.Setenv("FX_CONFIG", filepath.Join(dir, "config.yml"))
+	code := m.Run()
+	os.RemoveAll(dir)
+	os.Exit(code)
+}
+
+func writePack(t *testing.T, name string, files map[string]string) {
+	dir, err := PacksDir()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for path, content := range files {
+		filePath := filepath.Join(dir, name, path)
+		if err := os.MkdirAll(filepath.Dir(filePath), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(filePath, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func removePacks(t *testing.T) {
+	dir, err := PacksDir()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.RemoveAll(dir); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestUserPack(t *testing.T) {
+	defer removePacks(t)
+	writePack(t, "company-python", map[string]string{
+		DescriptorFile:     "extensions:\n  - .py\nhandler: handler.py\ntemplates:\n  - Dockerfile\n  - app.py\n  - handler.py\n  - requirements.txt\n  - lib\n",
+		"Dockerfile":       "FROM registry.example.com/python:3.8\nCOPY . .\nCMD python app.py\n",
+		"app.py":           "from handler import fx\n",
+		"handler.py":       "def fx(ctx):\n    pass\n",
+		"requirements.txt": "company-sdk==1.2.0\n",
+		"lib/auth.py":      "TOKEN = ''\n",
+		"README.md":        "not a template\n",
+	})
+
+	input, err := ioutil.TempDir("", "fx-pack-input")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(input)
+	source := filepath.Join(input, "fx.py")
+	if err := ioutil.WriteFile(source, []byte("def fx(ctx):\n    return 'hello'\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	found := false
+	for _, lang := range Languages() {
+		if lang == "company-python" {
+			found = true
+		}
+	}
+	if !found {
+		t.Fatalf("should list company-python in %v", Languages())
+	}
+
+	t.Run("single file", func(t *testing.T) {
+		output, err := ioutil.TempDir("", "fx-pack")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer os.RemoveAll(output)
+
+		if err := Pack(output, source); err != nil {
+			t.Fatal(err)
+		}
+		for _, path := range []string{"Dockerfile", "app.py", "handler.py", "requirements.txt", "lib/auth.py"} {
+			if _, err := os.Stat(filepath.Join(output, path)); err != nil {
+				t.Fatalf("should have %s: %s", path, err)
+			}
+		}
+		if _, err := os.Stat(filepath.Join(output, "README.md")); err == nil {
+			t.Fatalf("should not have files out of templates")
+		}
+		handler, err := ioutil.ReadFile(filepath.Join(output, "handler.py"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(handler) != "def fx(ctx):\n    return 'hello'\n" {
+			t.Fatalf("should replace handler with the source but got %s", handler)
+		}
+	})
+
+	t.Run("directory", func(t *testing.T) {
+		output, err := ioutil.TempDir("", "fx-pack")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer os.RemoveAll(output)
+
+		input, err := ioutil.TempDir("", "fx-pack-input")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer os.RemoveAll(input)
+		if err := ioutil.WriteFile(filepath.Join(input, "fx.py"), []byte("def fx(ctx):\n    pass\n"), 0644); err != nil {
+			t.Fatal(err)
+		}
+		if err := Pack(output, input); err == nil || !strings.Contains(err.Error(), "handler.py") {
+			t.Fatalf("should require handler of pack but got %v", err)
+		}
+
+		if err := ioutil.WriteFile(filepath.Join(input, "handler.py"), []byte("def fx(ctx):\n    pass\n"), 0644); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(filepath.Join(input, "requirements.txt"), []byte("requests==2.22.0\n"), 0644); err != nil {
+			t.Fatal(err)
+		}
+		if err := Pack(output, input); err != nil {
+			t.Fatal(err)
+		}
+		requirements, err := ioutil.ReadFile(filepath.Join(output, "requirements.txt"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(requirements) != "company-sdk==1.2.0\nrequests==2.22.0\n" {
+			t.Fatalf("should merge requirements.txt with the one of pack but got %q", requirements)
+		}
+	})
+
+	t.Run("built-in language", func(t *testing.T) {
+		output, err := ioutil.TempDir("", "fx-pack")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer os.RemoveAll(output)
+
+		if err := PackWithLanguage(output, "python", source); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := os.Stat(filepath.Join(output, "fx.py")); err != nil {
+			t.Fatalf("should pack with the built-in one when it's given: %s", err)
+		}
+	})
+
+	t.Run("scaffold", func(t *testing.T) {
+		files, err := scaffoldFiles("company-python", "my-func")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(files) != 1 || files["handler.py"] == nil {
+			t.Fatalf("should scaffold handler of pack but got %v", files)
+		}
+	})
+}
+
+func TestInvalidUserPack(t *testing.T) {
+	cases := map[string]map[string]string{
+		"no extensions": {
+			DescriptorFile: "handler: fx.py\ntemplates:\n  - Dockerfile\n",
+			"Dockerfile":   "FROM python\n",
+		},
+		"bad extension": {
+			DescriptorFile: "extensions:\n  - py\nhandler: fx.py\ntemplates:\n  - Dockerfile\n",
+			"Dockerfile":   "FROM python\n",
+		},
+		"bad handler": {
+			DescriptorFile: "extensions:\n  - .py\nhandler: '[fx'\ntemplates:\n  - Dockerfile\n",
+			"Dockerfile":   "FROM python\n",
+		},
+		"missing template": {
+			DescriptorFile: "extensions:\n  - .py\nhandler: fx.py\ntemplates:\n  - Dockerfile\n  - fx.py\n",
+			"Dockerfile":   "FROM python\n",
+		},
+		"template out of pack": {
+			DescriptorFile: "extensions:\n  - .py\nhandler: fx.py\ntemplates:\n  - ../Dockerfile\n",
+		},
+		"no handler in templates": {
+			DescriptorFile: "extensions:\n  - .py\nhandler: fx.py\ntemplates:\n  - Dockerfile\n",
+			"Dockerfile":   "FROM python\n",
+		},
+	}
+	for name, files := range cases {
+		t.Run(name, func(t *testing.T) {
+			defer removePacks(t)
+			writePack(t, "broken", files)
+
+			output, err := ioutil.TempDir("", "fx-pack")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer os.RemoveAll(output)
+
+			if err := Pack(output, "./fixture/p1/fx.js"); err != nil {
+				t.Fatalf("should pack other languages with invalid pack ignored but got %v", err)
+			}
+			if err := PackWithLanguage(output, "broken", "./fixture/p1/fx.js"); err == nil || !strings.Contains(err.Error(), "invalid pack broken") {
+				t.Fatalf("should report invalid pack but got %v", err)
+			}
+			if _, err := scaffoldFiles("broken", "my-func"); err == nil || !strings.Contains(err.Error(), "invalid pack broken") {
+				t.Fatalf("should report invalid pack but got %v", err)
+			}
+		})
+	}
+}
@@ -1,12 +1,17 @@
 package packer
 
 import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 
 	"github.com/gobuffalo/packr"
@@ -22,36 +27,43 @@ func init() {
 
 // Pack pack a file or directory into a Docker project
 func Pack(output string, input ...string) error {
+	return PackWithLanguage(output, "", input...)
+}
+
+// PackWithLanguage pack a file or directory into a Docker project of given language,
+// language is detected from the input source codes when it's empty
+func PackWithLanguage(output string, lang string, input ...string) error {
 	if len(input) == 0 {
 		return fmt.Errorf("source file or directory required")
 	}
 
-	var lang string
-	for _, f := range input {
-		if utils.IsRegularFile(f) {
-			lang = langFromFileName(f)
-		} else if utils.IsDir(f) {
-			if err := filepath.Walk(f, func(path string, info os.FileInfo, err error) error {
-				if err != nil {
-					return err
-				}
-				if utils.IsRegularFile(path) {
-					lang = langFromFileName(path)
-				}
-				return nil
-			}); err != nil {
-				return err
-			}
+	packs, invalid, err := loadPacks()
+	if err != nil {
+		return err
+	}
+	if lang == "" {
+		detected, err := detectLanguage(packs, input...)
+		if err != nil {
+			return err
 		}
+		lang = detected
 	}
-
 	if lang == "" {
 		return fmt.Errorf("could not tell programe language of your input source codes")
 	}
 
-	if err := restore(output, lang); err != nil {
+	if err := checkPacks(lang, invalid); err != nil {
+		return err
+	}
+
+	templates, err := templatesOf(lang, packs)
+	if err != nil {
 		return err
 	}
+	if err := restore(output, templates); err != nil {
+		return err
+	}
+	isHandler := handlerOf(lang, packs)
 
 	if len(input) == 1 {
 		stat, err := os.Stat(input[0])
@@ -64,7 +76,8 @@ func Pack(output string, input ...string) error {
 					return err
 				}
 
-				if isHandler(path) {
+				// directories could be named with fx too, e.g. src/main/java/fx of Java
+				if info.Mode().IsRegular() && isHandler(path) {
 					if err := copy.Copy(input[0], path); err != nil {
 						return err
 					}
@@ -74,11 +87,14 @@ func Pack(output string, input ...string) error {
 			}); err != nil {
 				return err
 			}
+			return nil
 		}
-		return nil
 	}
 
-	if !hasFxHandleFile(input...) {
+	if !hasFxHandleFile(isHandler, input...) {
+		if pack, ok := packs[lang]; ok {
+			return fmt.Errorf("it requires a handler file matching %s for %s when input is not a single file function", pack.Handler, lang)
+		}
 		msg := `it requires a fx handle file when input is not a single file function, e.g.  
 fx.go for Golang
 Fx.java for Java
@@ -87,32 +103,125 @@ fx.py for Python
 fx.js for JavaScript or Node
 fx.rb for Ruby
 fx.jl for Julia
-fx.d for D`
+fx.d for D
+fx.ts for Deno
+fx.lua for Lua
+fx.pl for Perl
+fx.cs for C#
+fx.kt for Kotlin`
 		return fmt.Errorf(msg)
 	}
 
 	if err := merge(output, input...); err != nil {
 		return err
 	}
-	return nil
+	return mergeManifests(output, templates)
+}
+
+// Languages languages fx could pack, both the built-in ones and the user-defined ones in PacksDir
+func Languages() []string {
+	seen := map[string]bool{}
+	langs := []string{}
+	for _, name := range presets.List() {
+		lang := strings.SplitN(name, "/", 2)[0]
+		if !seen[lang] {
+			seen[lang] = true
+			langs = append(langs, lang)
+		}
+	}
+	// invalid packs are reported when packing, they are not listed here
+	packs, _, _ := loadPacks()
+	for lang := range packs {
+		if !seen[lang] {
+			seen[lang] = true
+			langs = append(langs, lang)
+		}
+	}
+	sort.Strings(langs)
+	return langs
+}
+
+func detectLanguage(packs map[string]*userPack, input ...string) (string, error) {
+	var lang string
+	for _, f := range input {
+		// files of other types, e.g. dependency manifests, do not tell language
+		if utils.IsRegularFile(f) {
+			if l := langOf(packs, f); l != "" {
+				lang = l
+			}
+		} else if utils.IsDir(f) {
+			if err := filepath.Walk(f, func(path string, info os.FileInfo, err error) error {
+				if err != nil {
+					return err
+				}
+				if utils.IsRegularFile(path) {
+					if l := langOf(packs, path); l != "" {
+						lang = l
+					}
+				}
+				return nil
+			}); err != nil {
+				return "", err
+			}
+		}
+	}
+	return lang, nil
+}
+
+// langOf language of source file, user-defined packs are checked before the built-in ones
+func langOf(packs map[string]*userPack, fileName string) string {
+	names := []string{}
+	for name := range packs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if packs[name].claims(fileName) {
+			return name
+		}
+	}
+	return langFromFileName(fileName)
 }
 
-func restore(output string, lang string) error {
+// templatesOf files of Docker project of language keyed by their slash separated paths,
+// a user-defined pack replaces the built-in one of the same name
+func templatesOf(lang string, packs map[string]*userPack) (map[string][]byte, error) {
+	if pack, ok := packs[lang]; ok {
+		return pack.files()
+	}
+	prefix := fmt.Sprintf("%s/", lang)
+	templates := map[string][]byte{}
 	for _, name := range presets.List() {
-		prefix := fmt.Sprintf("%s/", lang)
 		if strings.HasPrefix(name, prefix) {
-			content, err := presets.FindString(name)
+			content, err := presets.Find(name)
 			if err != nil {
-				return err
+				return nil, err
 			}
+			templates[strings.TrimPrefix(name, prefix)] = content
+		}
+	}
+	if len(templates) == 0 {
+		return nil, fmt.Errorf("unsupported language %s", lang)
+	}
+	return templates, nil
+}
 
-			filePath := filepath.Join(output, strings.Replace(name, prefix, "", 1))
-			if err := utils.EnsureFile(filePath); err != nil {
-				return err
-			}
-			if err := ioutil.WriteFile(filePath, []byte(content), 0666); err != nil {
-				return err
-			}
+// handlerOf tell if a file is the handler file of language
+func handlerOf(lang string, packs map[string]*userPack) func(name string) bool {
+	if pack, ok := packs[lang]; ok {
+		return pack.isHandler
+	}
+	return isHandler
+}
+
+func restore(output string, templates map[string][]byte) error {
+	for name, content := range templates {
+		filePath := filepath.Join(output, filepath.FromSlash(name))
+		if err := utils.EnsureFile(filePath); err != nil {
+			return err
+		}
+		if err := ioutil.WriteFile(filePath, content, 0666); err != nil {
+			return err
 		}
 	}
 	return nil
@@ -163,21 +272,10 @@ func isHandler(name string) bool {
 }
 
 func langFromFileName(fileName string) string {
-	extLangMap := map[string]string{
-		".js":   "node",
-		".go":   "go",
-		".rb":   "ruby",
-		".py":   "python",
-		".php":  "php",
-		".jl":   "julia",
-		".java": "java",
-		".d":    "d",
-		".rs":   "rust",
-	}
-	return extLangMap[filepath.Ext(fileName)]
+	return utils.GetLangFromFileName(fileName)
 }
 
-func hasFxHandleFile(input ...string) bool {
+func hasFxHandleFile(isHandler func(name string) bool, input ...string) bool {
 	var handleFile string
 	for _, file := range input {
 		if utils.IsRegularFile(file) && isHandler(file) {
@@ -228,6 +326,69 @@ func PackIntoK8SConfigMapFile(dir string) (string, error) {
 	return base64.StdEncoding.WithPadding(base64.StdPadding).EncodeToString(data), nil
 }
 
+// PackIntoTar pack a Docker project into a tar archive, it's the build context of container runtimes
+func PackIntoTar(dir string) ([]byte, error) {
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	if err := writeTar(tw, dir); err != nil {
+		return nil, err
+	}
+	if err := tw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// PackIntoTarGz pack a Docker project into a gzipped tar archive, it's the build context of in-cluster builder
+func PackIntoTarGz(dir string) ([]byte, error) {
+	var buf bytes.Buffer
+	gw := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gw)
+	if err := writeTar(tw, dir); err != nil {
+		return nil, err
+	}
+	if err := tw.Close(); err != nil {
+		return nil, err
+	}
+	if err := gw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func writeTar(tw *tar.Writer, dir string) error {
+	return filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		relpath, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		if relpath == "." || !(info.IsDir() || info.Mode().IsRegular()) {
+			return nil
+		}
+		header, err := tar.FileInfoHeader(info, "")
+		if err != nil {
+			return err
+		}
+		header.Name = filepath.ToSlash(relpath)
+		if err := tw.WriteHeader(header); err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		_, err = io.Copy(tw, f)
+		return err
+	})
+}
+
 // TreeToDir restore to docker project
 func TreeToDir(tree map[string]string, outputDir string) error {
 	for k, v := range tree {
@@ -1,11 +1,17 @@
 package packer
 
 import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"log"
 	"os"
 	"os/exec"
+	"path/filepath"
+	"reflect"
 	"testing"
 	"time"
 
@@ -71,6 +77,33 @@ func TestPacker(t *testing.T) {
 	})
 }
 
+func TestPackIntoTarGz(t *testing.T) {
+	body, err := PackIntoTarGz("./fixture/p3")
+	if err != nil {
+		t.Fatal(err)
+	}
+	gr, err := gzip.NewReader(bytes.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tr := tar.NewReader(gr)
+	files := []string{}
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		files = append(files, header.Name)
+	}
+	expected := []string{"fx.js", "helper.js"}
+	if !reflect.DeepEqual(files, expected) {
+		t.Fatalf("should get %v but got %v", expected, files)
+	}
+}
+
 func TestTreeAndUnTree(t *testing.T) {
 	_, err := PackIntoK8SConfigMapFile("./fixture/p1")
 	if err != nil {
@@ -80,22 +113,34 @@ func TestTreeAndUnTree(t *testing.T) {
 
 func TestGenerate(t *testing.T) {
 	langs := []string{
+		"csharp",
 		"d",
+		"deno",
 		"go",
 		"java",
 		"julia",
+		"kotlin",
+		"lua",
 		"node",
+		"perl",
 		"php",
 		"python",
 		"ruby",
 		"rust",
 	}
+	if !reflect.DeepEqual(Languages(), langs) {
+		t.Fatalf("should get %v but got %v", langs, Languages())
+	}
 	for _, lang := range langs {
 		output := fmt.Sprintf("output-%s-%d", lang, time.Now().Unix())
 		defer func() {
 			os.RemoveAll(output)
 		}()
-		if err := restore(output, lang); err != nil {
+		templates, err := templatesOf(lang, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := restore(output, templates); err != nil {
 			t.Fatal(err)
 		}
 		diffCmd := exec.Command("diff", "-r", output, "./images/"+lang)
@@ -106,6 +151,55 @@ func TestGenerate(t *testing.T) {
 	}
 }
 
+func TestPackTestFunctions(t *testing.T) {
+	functions, err := filepath.Glob("../test/functions/func.*")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, function := range functions {
+		lang := langFromFileName(function)
+		if lang == "" {
+			t.Fatalf("should tell language of %s", function)
+		}
+		output, err := ioutil.TempDir("", "fx-"+lang)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer os.RemoveAll(output)
+
+		if err := Pack(output, function); err != nil {
+			t.Fatal(err)
+		}
+		if !utils.IsRegularFile(filepath.Join(output, "Dockerfile")) {
+			t.Fatalf("should have Dockerfile for %s", lang)
+		}
+		body, err := ioutil.ReadFile(function)
+		if err != nil {
+			t.Fatal(err)
+		}
+		handlers := 0
+		if err := filepath.Walk(output, func(path string, info os.FileInfo, err error) error {
+			if err != nil || !info.Mode().IsRegular() || !isHandler(path) {
+				return err
+			}
+			handlers++
+			content, err := ioutil.ReadFile(path)
+			if err != nil {
+				return err
+			}
+			if string(content) != string(body) {
+				t.Fatalf("should get %s as handler of %s but got %s", function, lang, content)
+			}
+			return nil
+		}); err != nil {
+			t.Fatal(err)
+		}
+		if handlers != 1 {
+			t.Fatalf("should get one handler of %s but got %d", lang, handlers)
+		}
+	}
+}
+
 func TestMerge(t *testing.T) {
 	// TODO should check the merge result
 	t.Run("NoInput", func(t *testing.T) {
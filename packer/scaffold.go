@@ -0,0 +1,104 @@
+package packer
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	"github.com/gobuffalo/packr"
+	"github.com/metrue/fx/utils"
+)
+
+// scaffolds dependency manifests and sample tests of function for each language, names of function in them
+// are rendered by text/template, e.g. '{{.Name}}'
+var scaffolds packr.Box
+
+func init() {
+	scaffolds = packr.NewBox("./scaffolds")
+}
+
+// Scaffold write a function of given language named with name into output directory, it has the handler file of
+// language, a dependency manifest and a sample test. Existing files are never overwritten
+func Scaffold(output string, lang string, name string) error {
+	files, err := scaffoldFiles(lang, name)
+	if err != nil {
+		return err
+	}
+	for path := range files {
+		if _, err := os.Stat(filepath.Join(output, path)); err == nil {
+			return fmt.Errorf("%s already exists", filepath.Join(output, path))
+		}
+	}
+	for path, content := range files {
+		filePath := filepath.Join(output, path)
+		if err := utils.EnsureFile(filePath); err != nil {
+			return err
+		}
+		if err := ioutil.WriteFile(filePath, content, 0644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// scaffoldFiles files of function keyed by their paths, the handler file is the same as the one of preset,
+// unless there is one with tests in scaffolds. A user-defined pack is scaffolded with its handler file only
+func scaffoldFiles(lang string, name string) (map[string][]byte, error) {
+	packs, invalid, err := loadPacks()
+	if err != nil {
+		return nil, err
+	}
+	if err := checkPacks(lang, invalid); err != nil {
+		return nil, err
+	}
+	if !isLanguage(lang) {
+		return nil, fmt.Errorf("unsupported language %s, it should be one of %s", lang, strings.Join(Languages(), ", "))
+	}
+	templates, err := templatesOf(lang, packs)
+	if err != nil {
+		return nil, err
+	}
+	isHandler := handlerOf(lang, packs)
+	files := map[string][]byte{}
+	for path, content := range templates {
+		if isHandler(path) {
+			files[path] = content
+		}
+	}
+	if _, ok := packs[lang]; ok {
+		return files, nil
+	}
+	prefix := lang + "/"
+	for _, path := range scaffolds.List() {
+		if !strings.HasPrefix(path, prefix) {
+			continue
+		}
+		content, err := scaffolds.FindString(path)
+		if err != nil {
+			return nil, err
+		}
+		tmpl, err := template.New(path).Parse(content)
+		if err != nil {
+			return nil, err
+		}
+		var buf bytes.Buffer
+		if err := tmpl.Execute(&buf, struct{ Name string }{Name: name}); err != nil {
+			return nil, err
+		}
+		files[strings.TrimPrefix(path, prefix)] = buf.Bytes()
+	}
+	return files, nil
+}
+
+func isLanguage(lang string) bool {
+	for _, l := range Languages() {
+		if l == lang {
+			return true
+		}
+	}
+	return false
+}
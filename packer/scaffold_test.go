@@ -0,0 +1,70 @@
+package packer
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestScaffold(t *testing.T) {
+	dir, err := ioutil.TempDir("", "fx-scaffold")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	for _, lang := range Languages() {
+		output := filepath.Join(dir, lang)
+		if err := Scaffold(output, lang, "my-func"); err != nil {
+			t.Fatal(err)
+		}
+		if !hasFxHandleFile(isHandler, output) {
+			t.Fatalf("should have handler file of %s", lang)
+		}
+		files, err := scaffoldFiles(lang, "my-func")
+		if err != nil {
+			t.Fatal(err)
+		}
+		// handler, dependency manifest and sample test, tests of Rust are in its handler file
+		expect := 3
+		if lang == "rust" {
+			expect = 2
+		}
+		if len(files) < expect {
+			t.Fatalf("should get handler, manifest and test of %s but got %d files", lang, len(files))
+		}
+		for path, content := range files {
+			if strings.Contains(string(content), "{{") {
+				t.Fatalf("should render name into %s of %s", path, lang)
+			}
+		}
+	}
+
+	body, err := ioutil.ReadFile(filepath.Join(dir, "node", "package.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(body), `"name": "my-func"`) {
+		t.Fatalf("should get name of function in package.json but got %s", body)
+	}
+	handler, err := ioutil.ReadFile(filepath.Join(dir, "python", "fx.py"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	preset, err := presets.Find("python/fx.py")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(handler) != string(preset) {
+		t.Fatalf("should get handler of preset but got %s", handler)
+	}
+
+	if err := Scaffold(filepath.Join(dir, "python"), "python", "my-func"); err == nil {
+		t.Fatalf("should not overwrite existing files")
+	}
+	if err := Scaffold(filepath.Join(dir, "cobol"), "cobol", "my-func"); err == nil {
+		t.Fatalf("should get error of unsupported language")
+	}
+}
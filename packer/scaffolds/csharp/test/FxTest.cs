@@ -0,0 +1,19 @@
+// run with 'dotnet test test'
+using System.IO;
+using System.Threading.Tasks;
+using Microsoft.AspNetCore.Http;
+using Xunit;
+
+public class FxTest
+{
+    [Fact]
+    public async Task Handle()
+    {
+        var ctx = new DefaultHttpContext();
+        ctx.Response.Body = new MemoryStream();
+        await Fx.Handle(ctx);
+
+        ctx.Response.Body.Position = 0;
+        Assert.Equal("hello world", new StreamReader(ctx.Response.Body).ReadToEnd());
+    }
+}
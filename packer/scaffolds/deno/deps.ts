@@ -0,0 +1,2 @@
+// dependencies of function, e.g. export * as path from "https://deno.land/std@0.50.0/path/mod.ts";
+export {};
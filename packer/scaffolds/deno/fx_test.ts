@@ -0,0 +1,9 @@
+import { assertEquals } from "https://deno.land/std@0.50.0/testing/asserts.ts";
+import fx from "./fx.ts";
+
+Deno.test("fx", async () => {
+  // request is the ServerRequest of std http server, it's not used by fx
+  const ctx = { request: {} as any, body: "" };
+  await fx(ctx);
+  assertEquals(ctx.body, "hello world");
+});
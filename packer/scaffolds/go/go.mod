@@ -0,0 +1,5 @@
+module {{.Name}}
+
+go 1.12
+
+require github.com/gin-gonic/gin v1.5.0
@@ -0,0 +1,14 @@
+package fx;
+
+import static org.junit.Assert.assertEquals;
+
+import org.json.JSONObject;
+import org.junit.Test;
+
+public class FxTest {
+    @Test
+    public void handle() {
+        JSONObject input = new JSONObject("{\"a\": 1, \"b\": 2}");
+        assertEquals(3, new Fx().handle(input));
+    }
+}
@@ -0,0 +1,5 @@
+using Test
+
+include("../fx.jl")
+
+@test fx(Input(1, 2)) == 3
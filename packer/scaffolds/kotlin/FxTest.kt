@@ -0,0 +1,19 @@
+// sources are moved into src/main/kotlin and src/test/kotlin when image of function is built,
+// move them the same way to run it with 'gradle test'
+import io.javalin.Javalin
+import java.net.URL
+import kotlin.test.Test
+import kotlin.test.assertEquals
+
+class FxTest {
+    @Test
+    fun hello() {
+        val app = Javalin.create().start(0)
+        app.get("/") { ctx -> fx(ctx) }
+        try {
+            assertEquals("hello world", URL("http://localhost:${app.port()}/").readText())
+        } finally {
+            app.stop()
+        }
+    }
+}
@@ -0,0 +1,11 @@
+-- run with 'lua fx_test.lua', ctx is ngx of OpenResty, only the fields used by fx are faked here
+package.path = "./?.lua;" .. package.path
+local fx = require("fx")
+
+local said = {}
+local ctx = {
+  say = function(s) table.insert(said, s) end
+}
+fx(ctx)
+assert(table.concat(said) == "hello world", "should get hello world but got " .. table.concat(said))
+print("ok")
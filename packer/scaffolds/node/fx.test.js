@@ -0,0 +1,8 @@
+const assert = require('assert')
+const fx = require('./fx')
+
+// ctx is a Koa context, only the fields used by fx are faked here
+const ctx = { request: { body: {} } }
+fx(ctx)
+assert.strictEqual(ctx.body, 'hello world')
+console.log('ok')
@@ -0,0 +1,18 @@
+# run with 'prove fx.t'
+use strict;
+use warnings;
+use Test::More;
+use Test::Mojo;
+use Mojolicious::Lite;
+
+require './fx.pl';
+
+any '/' => sub {
+  my $c = shift;
+  fx($c);
+};
+
+my $t = Test::Mojo->new;
+$t->get_ok('/')->status_is(200)->content_is('hello world');
+
+done_testing();
@@ -0,0 +1,10 @@
+<?php
+    include("fx.php");
+
+    // input is the decoded JSON body of request
+    $v = Fx(array("a" => 1, "b" => 2));
+    if ($v !== 3) {
+        fwrite(STDERR, "should get 3 but got " . var_export($v, true) . "\n");
+        exit(1);
+    }
+    echo "ok\n";
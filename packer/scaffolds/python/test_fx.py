@@ -0,0 +1,13 @@
+import unittest
+
+from fx import fx
+
+
+class TestFx(unittest.TestCase):
+    def test_fx(self):
+        # request is a Flask request, it's not used by fx
+        self.assertEqual(fx(None), "hello world")
+
+
+if __name__ == "__main__":
+    unittest.main()
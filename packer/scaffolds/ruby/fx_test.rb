@@ -0,0 +1,18 @@
+require 'minitest/autorun'
+require 'ostruct'
+
+require_relative 'fx.rb'
+
+class FxTest < Minitest::Test
+  def test_fx
+    # ctx is built from a Sinatra request in app.rb
+    ctx = {
+      :request => nil,
+      :response => OpenStruct.new,
+      :status => 200,
+      :headers => {},
+    }
+    fx ctx
+    assert_equal "hello world", ctx[:response].body
+  end
+end
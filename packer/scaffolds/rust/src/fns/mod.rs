@@ -0,0 +1,28 @@
+pub mod fns {
+    #[derive(Serialize)]
+    pub struct Response {
+        pub result: i32,
+    }
+
+    #[derive(Deserialize)]
+    pub struct Request {
+        pub a: i32,
+        pub b: i32,
+    }
+
+    pub fn func(req: Request) -> Response {
+        Response {
+            result: req.a + req.b,
+        }
+    }
+}
+
+#[cfg(test)]
+mod tests {
+    use super::fns::{func, Request};
+
+    #[test]
+    fn sum() {
+        assert_eq!(func(Request { a: 1, b: 2 }).result, 3);
+    }
+}
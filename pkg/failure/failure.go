@@ -0,0 +1,171 @@
+package failure
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/pkg/errors"
+)
+
+// Kind of failure
+type Kind string
+
+// kinds of failure, a failure which is not typed is KindUnknown
+const (
+	KindUnknown   Kind = "unknown"
+	KindUsage     Kind = "usage"
+	KindConfig    Kind = "config"
+	KindProvision Kind = "provision"
+	KindBuild     Kind = "build"
+	KindDeploy    Kind = "deploy"
+	KindNotFound  Kind = "not_found"
+)
+
+// exit codes of fx, they are stable and documented, scripts could rely on them
+const (
+	CodeUnknown   = 1
+	CodeUsage     = 2
+	CodeConfig    = 3
+	CodeProvision = 4
+	CodeBuild     = 5
+	CodeDeploy    = 6
+	CodeNotFound  = 7
+)
+
+var codes = map[Kind]int{
+	KindUnknown:   CodeUnknown,
+	KindUsage:     CodeUsage,
+	KindConfig:    CodeConfig,
+	KindProvision: CodeProvision,
+	KindBuild:     CodeBuild,
+	KindDeploy:    CodeDeploy,
+	KindNotFound:  CodeNotFound,
+}
+
+// Error a typed error, the stack where it's created is recorded
+type Error struct {
+	Kind Kind
+	err  error
+}
+
+// Error message of error
+func (e *Error) Error() string {
+	return e.err.Error()
+}
+
+// Cause the underlying error
+func (e *Error) Cause() error {
+	return errors.Cause(e.err)
+}
+
+// Code exit code of error
+func (e *Error) Code() int {
+	return codes[e.Kind]
+}
+
+// Stack message of error with the stack where it's created
+func (e *Error) Stack() string {
+	return fmt.Sprintf("%+v", e.err)
+}
+
+// New a typed error, err is returned as it is when it's nil or typed already,
+// so that the kind of the first place knows what happened is kept
+func New(kind Kind, err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := err.(*Error); ok {
+		return err
+	}
+	return &Error{Kind: kind, err: errors.WithStack(err)}
+}
+
+// Usage error of invalid arguments or flags
+func Usage(err error) error {
+	return New(KindUsage, err)
+}
+
+// Config error of loading or saving config
+func Config(err error) error {
+	return New(KindConfig, err)
+}
+
+// Provision error of connecting or provisioning infrastructure
+func Provision(err error) error {
+	return New(KindProvision, err)
+}
+
+// Build error of packing or building image
+func Build(err error) error {
+	return New(KindBuild, err)
+}
+
+// Deploy error of deploying or updating service
+func Deploy(err error) error {
+	return New(KindDeploy, err)
+}
+
+// NotFound error of a missing service, infrastructure or secret
+func NotFound(err error) error {
+	return New(KindNotFound, err)
+}
+
+// KindOf get kind of err, KindUnknown when it's not typed
+func KindOf(err error) Kind {
+	if e, ok := err.(*Error); ok {
+		return e.Kind
+	}
+	return KindUnknown
+}
+
+// CodeOf get exit code of err
+func CodeOf(err error) int {
+	return codes[KindOf(err)]
+}
+
+// IsNotFound check if err is a NotFound error
+func IsNotFound(err error) bool {
+	return KindOf(err) == KindNotFound
+}
+
+// Report print err to w, as a JSON object when format is 'json', stack is printed only when debug,
+// the exit code of err is returned
+func Report(w io.Writer, err error, format string, debug bool) int {
+	code := CodeOf(err)
+	stack := ""
+	if debug {
+		if e, ok := err.(*Error); ok {
+			stack = e.Stack()
+		} else {
+			stack = fmt.Sprintf("%+v", err)
+		}
+	}
+
+	if format == "json" {
+		obj := struct {
+			Error struct {
+				Kind    Kind   `json:"kind"`
+				Code    int    `json:"code"`
+				Message string `json:"message"`
+				Stack   string `json:"stack,omitempty"`
+			} `json:"error"`
+		}{}
+		obj.Error.Kind = KindOf(err)
+		obj.Error.Code = code
+		obj.Error.Message = err.Error()
+		obj.Error.Stack = stack
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		// nolint: errcheck
+		encoder.Encode(obj)
+		return code
+	}
+
+	if stack != "" {
+		fmt.Fprintf(w, "Error: %s\n", stack)
+	} else {
+		fmt.Fprintf(w, "Error: %s\n", err)
+	}
+	return code
+}
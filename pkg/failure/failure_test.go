@@ -0,0 +1,78 @@
+package failure
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestFailure(t *testing.T) {
+	if New(KindBuild, nil) != nil {
+		t.Fatalf("should get nil when there is no error")
+	}
+
+	cause := fmt.Errorf("no such service hello")
+	err := Deploy(NotFound(cause))
+	if KindOf(err) != KindNotFound {
+		t.Fatalf("should get %s but got %s", KindNotFound, KindOf(err))
+	}
+	if CodeOf(err) != CodeNotFound {
+		t.Fatalf("should get %d but got %d", CodeNotFound, CodeOf(err))
+	}
+	if err.Error() != cause.Error() {
+		t.Fatalf("should get %s but got %s", cause, err)
+	}
+	if err.(*Error).Cause() != cause {
+		t.Fatalf("should get %v but got %v", cause, err.(*Error).Cause())
+	}
+	if CodeOf(cause) != CodeUnknown {
+		t.Fatalf("should get %d but got %d", CodeUnknown, CodeOf(cause))
+	}
+}
+
+func TestReport(t *testing.T) {
+	err := Build(fmt.Errorf("could not build image"))
+
+	t.Run("text", func(t *testing.T) {
+		var buf bytes.Buffer
+		if code := Report(&buf, err, "", false); code != CodeBuild {
+			t.Fatalf("should get %d but got %d", CodeBuild, code)
+		}
+		if buf.String() != "Error: could not build image\n" {
+			t.Fatalf("should get %s but got %s", "Error: could not build image\n", buf.String())
+		}
+	})
+
+	t.Run("debug", func(t *testing.T) {
+		var buf bytes.Buffer
+		Report(&buf, err, "", true)
+		if !strings.Contains(buf.String(), "failure.TestReport") {
+			t.Fatalf("should get stack but got %s", buf.String())
+		}
+	})
+
+	t.Run("json", func(t *testing.T) {
+		var buf bytes.Buffer
+		if code := Report(&buf, err, "json", false); code != CodeBuild {
+			t.Fatalf("should get %d but got %d", CodeBuild, code)
+		}
+		var obj map[string]map[string]interface{}
+		if err := json.Unmarshal(buf.Bytes(), &obj); err != nil {
+			t.Fatal(err)
+		}
+		if obj["error"]["kind"] != string(KindBuild) {
+			t.Fatalf("should get %s but got %v", KindBuild, obj["error"]["kind"])
+		}
+		if obj["error"]["code"] != float64(CodeBuild) {
+			t.Fatalf("should get %d but got %v", CodeBuild, obj["error"]["code"])
+		}
+		if obj["error"]["message"] != "could not build image" {
+			t.Fatalf("should get %s but got %v", "could not build image", obj["error"]["message"])
+		}
+		if _, ok := obj["error"]["stack"]; ok {
+			t.Fatalf("should not get stack without debug")
+		}
+	})
+}
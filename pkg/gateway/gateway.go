@@ -0,0 +1,238 @@
+package gateway
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/apex/log"
+	dockerTypes "github.com/docker/docker/api/types"
+	"github.com/metrue/fx/constants"
+	containerruntimes "github.com/metrue/fx/container_runtimes"
+	"github.com/metrue/fx/types"
+)
+
+const (
+	// ContainerName name of gateway container
+	ContainerName = "fx-gateway"
+	// Image image of gateway container
+	Image = "metrue/fx-gateway"
+	// Port port gateway listens on in container
+	Port = 8080
+	// RoutesDir directory of route table in gateway container
+	RoutesDir = "/etc/fx-gateway"
+	// RoutesFile file name of route table
+	RoutesFile = "routes.json"
+	// Upstream route of a port proxy, every request is routed to it as it is
+	Upstream = "*"
+	// UpstreamEnv environment variable of port proxy container, the upstream it routes to when it starts the first time
+	UpstreamEnv = "FX_UPSTREAM"
+)
+
+// Routes route table, function name to its upstream URL
+type Routes map[string]string
+
+// RoutesOf route every service to its container by network alias on fx-net,
+// the name of container is its network alias
+func RoutesOf(services []types.Service) Routes {
+	routes := Routes{}
+	for _, s := range services {
+		name := strings.TrimPrefix(s.Name, "/")
+		if name == "" || name == ContainerName {
+			continue
+		}
+		routes[name] = URLOf(name)
+	}
+	return routes
+}
+
+// URLOf upstream URL of a function container on fx-net by its network alias
+func URLOf(name string) string {
+	return fmt.Sprintf("http://%s:%d", name, constants.FxContainerExposePort)
+}
+
+// SeedRoutes write route table of a port proxy routing to upstream into file, when there is no route in it yet,
+// the upstream switched to by an update is kept when the proxy restarts
+func SeedRoutes(file string, upstream string) error {
+	routes := Routes{}
+	if body, err := ioutil.ReadFile(file); err == nil {
+		if err := json.Unmarshal(body, &routes); err == nil && len(routes) > 0 {
+			return nil
+		}
+	}
+	body, err := json.Marshal(Routes{Upstream: upstream})
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(file, body, 0644)
+}
+
+// Archive route table into a tar archive, which could be copied into RoutesDir of gateway container
+func Archive(routes Routes) (io.Reader, error) {
+	body, err := json.MarshalIndent(routes, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	if err := tw.WriteHeader(&tar.Header{
+		Name:    RoutesFile,
+		Mode:    0644,
+		Size:    int64(len(body)),
+		ModTime: time.Now(),
+	}); err != nil {
+		return nil, err
+	}
+	if _, err := tw.Write(body); err != nil {
+		return nil, err
+	}
+	if err := tw.Close(); err != nil {
+		return nil, err
+	}
+	return &buf, nil
+}
+
+// Gateway a reverse proxy routes /<function name>/... to the function
+type Gateway struct {
+	mux     sync.RWMutex
+	routes  Routes
+	proxies map[string]*httputil.ReverseProxy
+}
+
+// New a gateway
+func New() *Gateway {
+	return &Gateway{
+		routes:  Routes{},
+		proxies: map[string]*httputil.ReverseProxy{},
+	}
+}
+
+// SetRoutes replace the route table
+func (g *Gateway) SetRoutes(routes Routes) error {
+	proxies := map[string]*httputil.ReverseProxy{}
+	for name, upstream := range routes {
+		target, err := url.Parse(upstream)
+		if err != nil {
+			return fmt.Errorf("invalid upstream %s of %s: %v", upstream, name, err)
+		}
+		proxies[name] = httputil.NewSingleHostReverseProxy(target)
+	}
+
+	g.mux.Lock()
+	defer g.mux.Unlock()
+	g.routes = routes
+	g.proxies = proxies
+	return nil
+}
+
+// Routes current route table
+func (g *Gateway) Routes() Routes {
+	g.mux.RLock()
+	defer g.mux.RUnlock()
+	routes := Routes{}
+	for k, v := range g.routes {
+		routes[k] = v
+	}
+	return routes
+}
+
+// ServeHTTP proxy /<function name>/rest/of/path to /rest/of/path of the function,
+// or proxy every request as it is when it's a port proxy with Upstream route
+func (g *Gateway) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	g.mux.RLock()
+	upstream, ok := g.proxies[Upstream]
+	g.mux.RUnlock()
+	if ok {
+		upstream.ServeHTTP(w, r)
+		return
+	}
+
+	parts := strings.SplitN(strings.TrimPrefix(r.URL.Path, "/"), "/", 2)
+	name := parts[0]
+
+	g.mux.RLock()
+	proxy, ok := g.proxies[name]
+	g.mux.RUnlock()
+	if !ok {
+		http.Error(w, fmt.Sprintf("no function named %s", name), http.StatusNotFound)
+		return
+	}
+
+	r.URL.Path = "/"
+	if len(parts) == 2 {
+		r.URL.Path += parts[1]
+	}
+	r.URL.RawPath = ""
+	proxy.ServeHTTP(w, r)
+}
+
+// Watch reload route table from file when it's changed, until stop closed.
+// Content of file is compared instead of modification time, since archive keeps modification time in seconds only
+func (g *Gateway) Watch(file string, interval time.Duration, stop <-chan struct{}) {
+	var loaded []byte
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if body, err := ioutil.ReadFile(file); err == nil && !bytes.Equal(body, loaded) {
+			routes := Routes{}
+			if err := json.Unmarshal(body, &routes); err != nil {
+				log.Warnf("could not load routes from %s: %v", file, err)
+			} else if err := g.SetRoutes(routes); err != nil {
+				log.Warnf("could not apply routes from %s: %v", file, err)
+			} else {
+				loaded = body
+				log.Infof("%d routes loaded from %s", len(routes), file)
+			}
+		}
+
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RoutesPath path of route table in gateway container
+func RoutesPath() string {
+	return filepath.Join(RoutesDir, RoutesFile)
+}
+
+// IsRunning check if gateway container is running
+func IsRunning(ctx context.Context, runtime containerruntimes.ContainerRuntime) bool {
+	var container dockerTypes.ContainerJSON
+	if err := runtime.InspectContainer(ctx, ContainerName, &container); err != nil {
+		return false
+	}
+	return container.ContainerJSONBase != nil &&
+		container.State != nil &&
+		container.State.Running
+}
+
+// Refresh route table of gateway container with the functions running, nothing to do when gateway is not running
+func Refresh(ctx context.Context, runtime containerruntimes.ContainerRuntime) error {
+	if !IsRunning(ctx, runtime) {
+		return nil
+	}
+	services, err := runtime.ListContainer(ctx, "")
+	if err != nil {
+		return err
+	}
+	archive, err := Archive(RoutesOf(services))
+	if err != nil {
+		return err
+	}
+	return runtime.CopyToContainer(ctx, ContainerName, RoutesDir, archive)
+}
@@ -0,0 +1,196 @@
+package gateway
+
+import (
+	"archive/tar"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/metrue/fx/types"
+)
+
+func TestRoutesOf(t *testing.T) {
+	routes := RoutesOf([]types.Service{
+		types.Service{Name: "/hello"},
+		types.Service{Name: "/" + ContainerName},
+	})
+	if len(routes) != 1 {
+		t.Fatalf("should get %d routes but got %d", 1, len(routes))
+	}
+	if routes["hello"] != "http://hello:3000" {
+		t.Fatalf("should get %s but got %s", "http://hello:3000", routes["hello"])
+	}
+}
+
+func TestArchive(t *testing.T) {
+	archive, err := Archive(Routes{"hello": "http://hello:3000"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tr := tar.NewReader(archive)
+	header, err := tr.Next()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if header.Name != RoutesFile {
+		t.Fatalf("should get %s but got %s", RoutesFile, header.Name)
+	}
+	var routes Routes
+	if err := json.NewDecoder(tr).Decode(&routes); err != nil {
+		t.Fatal(err)
+	}
+	if routes["hello"] != "http://hello:3000" {
+		t.Fatalf("should get %s but got %s", "http://hello:3000", routes["hello"])
+	}
+}
+
+func TestGateway(t *testing.T) {
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(r.URL.Path))
+	}))
+	defer upstream.Close()
+
+	g := New()
+	if err := g.SetRoutes(Routes{"hello": upstream.URL}); err != nil {
+		t.Fatal(err)
+	}
+	server := httptest.NewServer(g)
+	defer server.Close()
+
+	cases := map[string]struct {
+		status int
+		body   string
+	}{
+		"/hello":         {http.StatusOK, "/"},
+		"/hello/a/b":     {http.StatusOK, "/a/b"},
+		"/missing/hello": {http.StatusNotFound, ""},
+	}
+	for path, expected := range cases {
+		resp, err := http.Get(server.URL + path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		body, err := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.StatusCode != expected.status {
+			t.Fatalf("should get %d but got %d for %s", expected.status, resp.StatusCode, path)
+		}
+		if expected.status == http.StatusOK && string(body) != expected.body {
+			t.Fatalf("should get %s but got %s for %s", expected.body, string(body), path)
+		}
+	}
+}
+
+func TestUpstream(t *testing.T) {
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(r.URL.Path))
+	}))
+	defer upstream.Close()
+
+	g := New()
+	if err := g.SetRoutes(Routes{Upstream: upstream.URL}); err != nil {
+		t.Fatal(err)
+	}
+	server := httptest.NewServer(g)
+	defer server.Close()
+
+	resp, err := http.Get(server.URL + "/hello/a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode != http.StatusOK || string(body) != "/hello/a" {
+		t.Fatalf("should proxy request as it is but got %d %s", resp.StatusCode, body)
+	}
+}
+
+func TestSeedRoutes(t *testing.T) {
+	dir, err := ioutil.TempDir("", "fx-gateway")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	file := filepath.Join(dir, RoutesFile)
+	if err := ioutil.WriteFile(file, []byte(`{}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	read := func() Routes {
+		body, err := ioutil.ReadFile(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		routes := Routes{}
+		if err := json.Unmarshal(body, &routes); err != nil {
+			t.Fatal(err)
+		}
+		return routes
+	}
+
+	if err := SeedRoutes(file, "http://hello-blue:3000"); err != nil {
+		t.Fatal(err)
+	}
+	if routes := read(); routes[Upstream] != "http://hello-blue:3000" {
+		t.Fatalf("should seed upstream but got %v", routes)
+	}
+
+	// upstream switched to is kept
+	if err := ioutil.WriteFile(file, []byte(`{"*":"http://hello-green:3000"}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := SeedRoutes(file, "http://hello-blue:3000"); err != nil {
+		t.Fatal(err)
+	}
+	if routes := read(); routes[Upstream] != "http://hello-green:3000" {
+		t.Fatalf("should keep upstream switched to but got %v", routes)
+	}
+}
+
+func TestWatch(t *testing.T) {
+	dir, err := ioutil.TempDir("", "fx-gateway")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	file := filepath.Join(dir, RoutesFile)
+	if err := ioutil.WriteFile(file, []byte(`{"hello":"http://hello:3000"}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	g := New()
+	stop := make(chan struct{})
+	defer close(stop)
+	go g.Watch(file, 10*time.Millisecond, stop)
+
+	wait := func(name string) {
+		for i := 0; i < 100; i++ {
+			if _, ok := g.Routes()[name]; ok {
+				return
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+		t.Fatalf("should get route of %s", name)
+	}
+	wait("hello")
+
+	if err := ioutil.WriteFile(file, []byte(`{"world":"http://world:3000"}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	wait("world")
+	if _, ok := g.Routes()["hello"]; ok {
+		t.Fatalf("should not get route of %s after it's removed", "hello")
+	}
+}
@@ -0,0 +1,86 @@
+package healthcheck
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/metrue/fx/infra"
+	"github.com/metrue/fx/types"
+)
+
+// DefaultTimeout how long to wait for a service to be healthy by default
+const DefaultTimeout = 60 * time.Second
+
+// TailLines lines of logs reported when a service failed
+const TailLines = "20"
+
+// Interval between checks
+var Interval = time.Second
+
+// requestTimeout timeout of a HTTP check
+const requestTimeout = 3 * time.Second
+
+// Wait until service is ready on deployer and answers HTTP on path of endpoint, endpoint is not checked
+// when it's empty, e.g. on Kubernetes the deployer checks it by itself. Any response means service is
+// answering, but a response of health path should not be an error.
+// It fails as soon as deployer reports that service exited or crashed, last lines of logs of service are in the error
+func Wait(ctx context.Context, deployer infra.Deployer, name string, endpoint string, path string, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	url := strings.TrimRight(endpoint, "/") + "/" + strings.TrimLeft(path, "/")
+	for {
+		ready, err := deployer.Ready(ctx, name)
+		if err != nil && ctx.Err() == nil {
+			return withLogs(deployer, name, err)
+		}
+		if ready && (endpoint == "" || answers(ctx, url, path != "")) {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			cause := fmt.Errorf("service %s is not ready after %s", name, timeout)
+			if endpoint != "" {
+				cause = fmt.Errorf("service %s is not answering on %s after %s", name, url, timeout)
+			}
+			return withLogs(deployer, name, cause)
+		case <-time.After(Interval):
+		}
+	}
+}
+
+// answers check if service answers HTTP on url, error response is not accepted when strict
+func answers(ctx context.Context, url string, strict bool) bool {
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return false
+	}
+	client := &http.Client{Timeout: requestTimeout}
+	resp, err := client.Do(req.WithContext(ctx))
+	if err != nil {
+		return false
+	}
+	defer resp.Body.Close()
+	if strict {
+		return resp.StatusCode < 400
+	}
+	return resp.StatusCode < 500
+}
+
+// withLogs append last lines of logs of service to cause, logs are fetched with a new context
+// since the one of waiting could be timeout already
+func withLogs(deployer infra.Deployer, name string, cause error) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	var buf bytes.Buffer
+	if err := deployer.Logs(ctx, name, types.LogOptions{Tail: TailLines}, &buf); err != nil || buf.Len() == 0 {
+		return cause
+	}
+	return fmt.Errorf("%v, last %s lines of logs:\n%s", cause, TailLines, strings.TrimRight(buf.String(), "\n"))
+}
@@ -0,0 +1,102 @@
+package healthcheck
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	mockDeployer "github.com/metrue/fx/infra/mocks"
+	"github.com/metrue/fx/types"
+)
+
+func TestWait(t *testing.T) {
+	Interval = 10 * time.Millisecond
+	name := "hello"
+	logs := func(ctx context.Context, name string, options types.LogOptions, w io.Writer) error {
+		_, err := w.Write([]byte("listening on 3000\npanic: no database\n"))
+		return err
+	}
+
+	t.Run("answering", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+		}))
+		defer server.Close()
+
+		deployer := mockDeployer.NewMockDeployer(ctrl)
+		gomock.InOrder(
+			deployer.EXPECT().Ready(gomock.Any(), name).Return(false, nil),
+			deployer.EXPECT().Ready(gomock.Any(), name).Return(true, nil),
+		)
+		if err := Wait(context.Background(), deployer, name, server.URL, "", time.Second); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	t.Run("health path", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		healthy := false
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/health" || !healthy {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer server.Close()
+
+		deployer := mockDeployer.NewMockDeployer(ctrl)
+		deployer.EXPECT().Ready(gomock.Any(), name).Return(true, nil).AnyTimes()
+		deployer.EXPECT().Logs(gomock.Any(), name, types.LogOptions{Tail: TailLines}, gomock.Any()).DoAndReturn(logs)
+		err := Wait(context.Background(), deployer, name, server.URL, "/health", 100*time.Millisecond)
+		if err == nil || !strings.Contains(err.Error(), "is not answering on "+server.URL+"/health") {
+			t.Fatalf("should get not answering error but got %v", err)
+		}
+
+		healthy = true
+		if err := Wait(context.Background(), deployer, name, server.URL, "/health", time.Second); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	t.Run("exited", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		deployer := mockDeployer.NewMockDeployer(ctrl)
+		deployer.EXPECT().Ready(gomock.Any(), name).Return(false, fmt.Errorf("container hello is exited with exit code 2"))
+		deployer.EXPECT().Logs(gomock.Any(), name, types.LogOptions{Tail: TailLines}, gomock.Any()).DoAndReturn(logs)
+		err := Wait(context.Background(), deployer, name, "http://127.0.0.1:1", "", time.Second)
+		if err == nil {
+			t.Fatalf("should get error when service exited")
+		}
+		expected := "container hello is exited with exit code 2, last 20 lines of logs:\nlistening on 3000\npanic: no database"
+		if err.Error() != expected {
+			t.Fatalf("should get %s but got %s", expected, err)
+		}
+	})
+
+	t.Run("not ready", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		deployer := mockDeployer.NewMockDeployer(ctrl)
+		deployer.EXPECT().Ready(gomock.Any(), name).Return(false, nil).AnyTimes()
+		deployer.EXPECT().Logs(gomock.Any(), name, types.LogOptions{Tail: TailLines}, gomock.Any()).Return(nil)
+		err := Wait(context.Background(), deployer, name, "", "", 50*time.Millisecond)
+		if err == nil || err.Error() != "service hello is not ready after 50ms" {
+			t.Fatalf("should get not ready error but got %v", err)
+		}
+	})
+}
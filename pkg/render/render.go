@@ -2,26 +2,40 @@ package render
 
 import (
 	"fmt"
-	"os"
 
 	"github.com/metrue/fx/types"
-	"github.com/olekukonko/tablewriter"
 )
 
-// Table output services as table format
-func Table(services []types.Service) {
-	data := [][]string{}
-	for _, s := range services {
-		col := []string{
-			s.ID,
-			s.Name,
-			fmt.Sprintf("%s:%d", s.Host, +s.Port),
+// Result result of deploying a function
+type Result struct {
+	Name    string
+	Service types.Service
+	Err     error
+}
+
+// result Result in the form to be rendered, the endpoint and error are given as strings
+type result struct {
+	Name     string         `json:"name" yaml:"name"`
+	Status   string         `json:"status" yaml:"status"`
+	Service  *types.Service `json:"service,omitempty" yaml:"service,omitempty"`
+	Endpoint string         `json:"endpoint,omitempty" yaml:"endpoint,omitempty"`
+	Error    string         `json:"error,omitempty" yaml:"error,omitempty"`
+}
+
+func resultsOf(results []Result) []result {
+	rs := []result{}
+	for _, r := range results {
+		if r.Err != nil {
+			rs = append(rs, result{Name: r.Name, Status: "failed", Error: r.Err.Error()})
+			continue
 		}
-		data = append(data, col)
+		service := r.Service
+		rs = append(rs, result{
+			Name:     r.Name,
+			Status:   "ok",
+			Service:  &service,
+			Endpoint: fmt.Sprintf("%s:%d", service.Host, service.Port),
+		})
 	}
-
-	table := tablewriter.NewWriter(os.Stdout)
-	table.SetHeader([]string{"ID", "Name", "Endpoint"})
-	table.AppendBulk(data)
-	table.Render()
+	return rs
 }
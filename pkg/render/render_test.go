@@ -1,19 +1,122 @@
 package render
 
 import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/metrue/fx/types"
+	"gopkg.in/yaml.v2"
 )
 
-func TestTable(t *testing.T) {
-	services := []types.Service{
-		types.Service{
-			ID:   "id-1",
-			Name: "name-1",
-			Host: "127.0.0.1",
-			Port: 1000,
+func TestResults(t *testing.T) {
+	results := []Result{
+		Result{
+			Name:    "name-1",
+			Service: types.Service{Name: "name-1", Host: "127.0.0.1", Port: 1000},
 		},
+		Result{
+			Name: "name-2",
+			Err:  fmt.Errorf("build failed"),
+		},
+	}
+
+	t.Run("table", func(t *testing.T) {
+		r, err := New("", "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		var buf bytes.Buffer
+		if err := r.Results(&buf, results); err != nil {
+			t.Fatal(err)
+		}
+		for _, s := range []string{"127.0.0.1:1000", "failed", "build failed"} {
+			if !strings.Contains(buf.String(), s) {
+				t.Fatalf("should get %s in %s", s, buf.String())
+			}
+		}
+	})
+
+	t.Run("json", func(t *testing.T) {
+		r, err := New(OutputJSON, "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		var buf bytes.Buffer
+		if err := r.Results(&buf, results); err != nil {
+			t.Fatal(err)
+		}
+		var got []result
+		if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+			t.Fatal(err)
+		}
+		if len(got) != 2 ||
+			got[0].Status != "ok" || got[0].Service == nil || got[0].Service.Port != 1000 ||
+			got[1].Status != "failed" || got[1].Service != nil || got[1].Error != "build failed" {
+			t.Fatalf("should get results of functions but got %s", buf.String())
+		}
+	})
+
+	t.Run("format", func(t *testing.T) {
+		r, err := New("", "{{.Name}} {{.Status}}")
+		if err != nil {
+			t.Fatal(err)
+		}
+		var buf bytes.Buffer
+		if err := r.Results(&buf, results); err != nil {
+			t.Fatal(err)
+		}
+		if buf.String() != "name-1 ok\nname-2 failed\n" {
+			t.Fatalf("should get %q but got %q", "name-1 ok\nname-2 failed\n", buf.String())
+		}
+	})
+}
+
+func TestResources(t *testing.T) {
+	resources := []types.Resource{
+		types.Resource{Service: "hello", Kind: "Deployment", Name: "hello"},
+		types.Resource{Service: "hello", Kind: "ConfigMap", Name: "hello"},
 	}
-	Table(services)
+
+	t.Run("table", func(t *testing.T) {
+		r, err := New("", "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		var buf bytes.Buffer
+		if err := r.Resources(&buf, resources); err != nil {
+			t.Fatal(err)
+		}
+		if !strings.Contains(buf.String(), "Deployment") || !strings.Contains(buf.String(), "ConfigMap") {
+			t.Fatalf("should get resources table but got %s", buf.String())
+		}
+	})
+
+	t.Run("yaml", func(t *testing.T) {
+		r, err := New(OutputYAML, "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		var buf bytes.Buffer
+		if err := r.Resources(&buf, resources); err != nil {
+			t.Fatal(err)
+		}
+		var got []types.Resource
+		if err := yaml.Unmarshal(buf.Bytes(), &got); err != nil {
+			t.Fatal(err)
+		}
+		if len(got) != 2 || got[1] != resources[1] {
+			t.Fatalf("should get %v but got %v", resources, got)
+		}
+
+		buf.Reset()
+		if err := r.Resources(&buf, nil); err != nil {
+			t.Fatal(err)
+		}
+		if strings.TrimSpace(buf.String()) != "[]" {
+			t.Fatalf("should get %s but got %s", "[]", buf.String())
+		}
+	})
 }
@@ -0,0 +1,320 @@
+package render
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"text/template"
+	"time"
+
+	"github.com/metrue/fx/types"
+	"github.com/olekukonko/tablewriter"
+	"gopkg.in/yaml.v2"
+)
+
+// output formats of renderer
+const (
+	OutputTable = "table"
+	OutputWide  = "wide"
+	OutputJSON  = "json"
+	OutputYAML  = "yaml"
+)
+
+// Renderer render services, infrastructures, results of deploying functions and resources of services
+type Renderer interface {
+	Services(w io.Writer, services []types.Service) error
+	Results(w io.Writer, results []Result) error
+	Resources(w io.Writer, resources []types.Resource) error
+	Infras(w io.Writer, infras []types.Infra) error
+	Infra(w io.Writer, infra types.Infra) error
+}
+
+// New a renderer of output, table is the default. format is a Go template executed for every
+// service, infrastructure, result or resource, e.g. '{{.Name}} {{.Port}}', it overrides output when it's given
+func New(output string, format string) (Renderer, error) {
+	if format != "" {
+		tmpl, err := template.New("format").Funcs(template.FuncMap{
+			"json": func(v interface{}) (string, error) {
+				b, err := json.Marshal(v)
+				return string(b), err
+			},
+		}).Parse(format)
+		if err != nil {
+			return nil, fmt.Errorf("invalid format %s: %v", format, err)
+		}
+		return &templateRenderer{tmpl: tmpl}, nil
+	}
+
+	switch output {
+	case "", OutputTable:
+		return &tableRenderer{}, nil
+	case OutputWide:
+		return &tableRenderer{wide: true}, nil
+	case OutputJSON:
+		return &jsonRenderer{}, nil
+	case OutputYAML:
+		return &yamlRenderer{}, nil
+	default:
+		return nil, fmt.Errorf("unsupported output %s, it should be 'table', 'wide', 'json' or 'yaml'", output)
+	}
+}
+
+// tableRenderer render as table, state, image, creation time and replicas of services are
+// rendered only when wide
+type tableRenderer struct {
+	wide bool
+}
+
+func (r *tableRenderer) Services(w io.Writer, services []types.Service) error {
+	header := []string{"ID", "Name", "Endpoint"}
+	if r.wide {
+		header = append(header, "State", "Image", "Created", "Replicas")
+	}
+	data := [][]string{}
+	for _, s := range services {
+		row := []string{
+			s.ID,
+			s.Name,
+			fmt.Sprintf("%s:%d", s.Host, s.Port),
+		}
+		if r.wide {
+			created := ""
+			if !s.Created.IsZero() {
+				created = s.Created.Format(time.RFC3339)
+			}
+			replicas := ""
+			if s.Replicas > 0 {
+				replicas = fmt.Sprintf("%d/%d", s.ReadyReplicas, s.Replicas)
+			}
+			row = append(row, s.State, s.Image, created, replicas)
+		}
+		data = append(data, row)
+	}
+	return r.render(w, header, data)
+}
+
+func (r *tableRenderer) Results(w io.Writer, results []Result) error {
+	data := [][]string{}
+	for _, res := range resultsOf(results) {
+		data = append(data, []string{res.Name, res.Status, res.Endpoint, res.Error})
+	}
+	return r.render(w, []string{"Name", "Status", "Endpoint", "Error"}, data)
+}
+
+func (r *tableRenderer) Resources(w io.Writer, resources []types.Resource) error {
+	data := [][]string{}
+	for _, res := range resources {
+		unlabeled := ""
+		if res.Unlabeled {
+			unlabeled = "yes"
+		}
+		data = append(data, []string{res.Service, res.Kind, res.Name, unlabeled})
+	}
+	return r.render(w, []string{"Service", "Kind", "Name", "Unlabeled"}, data)
+}
+
+func (r *tableRenderer) Infras(w io.Writer, infras []types.Infra) error {
+	header := []string{"Name", "Type", "Host", "Current"}
+	if r.wide {
+		header = append(header, "User", "Namespace", "Registry")
+	}
+	data := [][]string{}
+	for _, infra := range infras {
+		host := infra.Host
+		if infra.Endpoint != "" {
+			host = infra.Endpoint
+		} else if infra.Kubeconfig != "" {
+			host = infra.Kubeconfig
+		}
+		current := ""
+		if infra.Current {
+			current = "*"
+		}
+		row := []string{infra.Name, infra.Type, host, current}
+		if r.wide {
+			row = append(row, infra.User, infra.Namespace, infra.Registry)
+		}
+		data = append(data, row)
+	}
+	return r.render(w, header, data)
+}
+
+// Infra render details of infrastructure as a table of keys and values, empty values are skipped
+func (r *tableRenderer) Infra(w io.Writer, infra types.Infra) error {
+	data := [][]string{
+		[]string{"Name", infra.Name},
+		[]string{"Type", infra.Type},
+		[]string{"Current", strconv.FormatBool(infra.Current)},
+	}
+	for _, kv := range [][]string{
+		[]string{"Host", infra.Host},
+		[]string{"Endpoint", infra.Endpoint},
+		[]string{"User", infra.User},
+		[]string{"Kubeconfig", infra.Kubeconfig},
+		[]string{"Server", infra.Server},
+		[]string{"Namespace", infra.Namespace},
+		[]string{"Registry", infra.Registry},
+	} {
+		if kv[1] != "" {
+			data = append(data, kv)
+		}
+	}
+	keys := []string{}
+	for k := range infra.Settings {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		data = append(data, []string{"settings." + k, infra.Settings[k]})
+	}
+	return r.render(w, []string{"Key", "Value"}, data)
+}
+
+func (r *tableRenderer) render(w io.Writer, header []string, data [][]string) error {
+	table := tablewriter.NewWriter(w)
+	table.SetHeader(header)
+	table.AppendBulk(data)
+	table.Render()
+	return nil
+}
+
+// jsonRenderer render as a JSON array
+type jsonRenderer struct{}
+
+func (r *jsonRenderer) Services(w io.Writer, services []types.Service) error {
+	if services == nil {
+		services = []types.Service{}
+	}
+	return r.render(w, services)
+}
+
+func (r *jsonRenderer) Results(w io.Writer, results []Result) error {
+	return r.render(w, resultsOf(results))
+}
+
+func (r *jsonRenderer) Resources(w io.Writer, resources []types.Resource) error {
+	if resources == nil {
+		resources = []types.Resource{}
+	}
+	return r.render(w, resources)
+}
+
+func (r *jsonRenderer) Infras(w io.Writer, infras []types.Infra) error {
+	if infras == nil {
+		infras = []types.Infra{}
+	}
+	return r.render(w, infras)
+}
+
+func (r *jsonRenderer) Infra(w io.Writer, infra types.Infra) error {
+	return r.render(w, infra)
+}
+
+func (r *jsonRenderer) render(w io.Writer, v interface{}) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(v)
+}
+
+// yamlRenderer render as a YAML sequence
+type yamlRenderer struct{}
+
+func (r *yamlRenderer) Services(w io.Writer, services []types.Service) error {
+	if services == nil {
+		services = []types.Service{}
+	}
+	return r.render(w, services)
+}
+
+func (r *yamlRenderer) Results(w io.Writer, results []Result) error {
+	return r.render(w, resultsOf(results))
+}
+
+func (r *yamlRenderer) Resources(w io.Writer, resources []types.Resource) error {
+	if resources == nil {
+		resources = []types.Resource{}
+	}
+	return r.render(w, resources)
+}
+
+func (r *yamlRenderer) Infras(w io.Writer, infras []types.Infra) error {
+	if infras == nil {
+		infras = []types.Infra{}
+	}
+	return r.render(w, infras)
+}
+
+func (r *yamlRenderer) Infra(w io.Writer, infra types.Infra) error {
+	return r.render(w, infra)
+}
+
+func (r *yamlRenderer) render(w io.Writer, v interface{}) error {
+	b, err := yaml.Marshal(v)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(b)
+	return err
+}
+
+// templateRenderer execute template for every item, one line for each
+type templateRenderer struct {
+	tmpl *template.Template
+}
+
+func (r *templateRenderer) Services(w io.Writer, services []types.Service) error {
+	for _, s := range services {
+		if err := r.render(w, s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *templateRenderer) Results(w io.Writer, results []Result) error {
+	for _, res := range resultsOf(results) {
+		if err := r.render(w, res); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *templateRenderer) Resources(w io.Writer, resources []types.Resource) error {
+	for _, res := range resources {
+		if err := r.render(w, res); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *templateRenderer) Infras(w io.Writer, infras []types.Infra) error {
+	for _, infra := range infras {
+		if err := r.render(w, infra); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *templateRenderer) Infra(w io.Writer, infra types.Infra) error {
+	return r.render(w, infra)
+}
+
+func (r *templateRenderer) render(w io.Writer, v interface{}) error {
+	if err := r.tmpl.Execute(w, v); err != nil {
+		return err
+	}
+	_, err := fmt.Fprintln(w)
+	return err
+}
+
+var (
+	_ Renderer = &tableRenderer{}
+	_ Renderer = &jsonRenderer{}
+	_ Renderer = &yamlRenderer{}
+	_ Renderer = &templateRenderer{}
+)
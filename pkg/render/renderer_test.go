@@ -0,0 +1,176 @@
+package render
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/metrue/fx/types"
+	"gopkg.in/yaml.v2"
+)
+
+func TestRenderer(t *testing.T) {
+	services := []types.Service{
+		types.Service{
+			ID:            "id-1",
+			Name:          "name-1",
+			Host:          "127.0.0.1",
+			Port:          1000,
+			State:         "ready",
+			Image:         "image-1",
+			Created:       time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC),
+			Replicas:      2,
+			ReadyReplicas: 1,
+		},
+	}
+	infras := []types.Infra{
+		types.Infra{Name: "default", Type: "docker", Host: "127.0.0.1", User: "root", Current: true},
+		types.Infra{Name: "cluster", Type: "k8s", Kubeconfig: "/root/.fx/cluster.kubeconfig", Namespace: "fx"},
+	}
+
+	t.Run("table", func(t *testing.T) {
+		r, err := New("", "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		var buf bytes.Buffer
+		if err := r.Services(&buf, services); err != nil {
+			t.Fatal(err)
+		}
+		if !strings.Contains(buf.String(), "127.0.0.1:1000") || strings.Contains(buf.String(), "image-1") {
+			t.Fatalf("should get services table without image but got %s", buf.String())
+		}
+	})
+
+	t.Run("wide", func(t *testing.T) {
+		r, err := New(OutputWide, "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		var buf bytes.Buffer
+		if err := r.Services(&buf, services); err != nil {
+			t.Fatal(err)
+		}
+		for _, s := range []string{"image-1", "2020-01-02T03:04:05Z", "1/2"} {
+			if !strings.Contains(buf.String(), s) {
+				t.Fatalf("should get %s in %s", s, buf.String())
+			}
+		}
+		buf.Reset()
+		if err := r.Infras(&buf, infras); err != nil {
+			t.Fatal(err)
+		}
+		for _, s := range []string{"/root/.fx/cluster.kubeconfig", "fx", "root", "*"} {
+			if !strings.Contains(buf.String(), s) {
+				t.Fatalf("should get %s in %s", s, buf.String())
+			}
+		}
+	})
+
+	t.Run("json", func(t *testing.T) {
+		r, err := New(OutputJSON, "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		var buf bytes.Buffer
+		if err := r.Services(&buf, services); err != nil {
+			t.Fatal(err)
+		}
+		var got []types.Service
+		if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+			t.Fatal(err)
+		}
+		if len(got) != 1 || got[0] != services[0] {
+			t.Fatalf("should get %v but got %v", services, got)
+		}
+
+		buf.Reset()
+		if err := r.Services(&buf, nil); err != nil {
+			t.Fatal(err)
+		}
+		if strings.TrimSpace(buf.String()) != "[]" {
+			t.Fatalf("should get %s but got %s", "[]", buf.String())
+		}
+	})
+
+	t.Run("yaml", func(t *testing.T) {
+		r, err := New(OutputYAML, "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		var buf bytes.Buffer
+		if err := r.Infras(&buf, infras); err != nil {
+			t.Fatal(err)
+		}
+		var got []types.Infra
+		if err := yaml.Unmarshal(buf.Bytes(), &got); err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(got, infras) {
+			t.Fatalf("should get %v but got %v", infras, got)
+		}
+	})
+
+	t.Run("format", func(t *testing.T) {
+		r, err := New(OutputJSON, "{{.Name}} {{.Port}}")
+		if err != nil {
+			t.Fatal(err)
+		}
+		var buf bytes.Buffer
+		if err := r.Services(&buf, services); err != nil {
+			t.Fatal(err)
+		}
+		if buf.String() != "name-1 1000\n" {
+			t.Fatalf("should get %s but got %s", "name-1 1000\n", buf.String())
+		}
+
+		if _, err := New("", "{{.Name"); err == nil {
+			t.Fatalf("should get error of invalid format")
+		}
+	})
+
+	t.Run("infra", func(t *testing.T) {
+		infra := types.Infra{
+			Name:     "cluster",
+			Type:     "k8s",
+			Server:   "https://10.0.0.1:6443",
+			Settings: map[string]string{"registry_secret": "regcred"},
+		}
+		r, err := New("", "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		var buf bytes.Buffer
+		if err := r.Infra(&buf, infra); err != nil {
+			t.Fatal(err)
+		}
+		for _, s := range []string{"https://10.0.0.1:6443", "settings.registry_secret", "regcred"} {
+			if !strings.Contains(buf.String(), s) {
+				t.Fatalf("should get %s in %s", s, buf.String())
+			}
+		}
+
+		r, err = New(OutputJSON, "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		buf.Reset()
+		if err := r.Infra(&buf, infra); err != nil {
+			t.Fatal(err)
+		}
+		var got types.Infra
+		if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(got, infra) {
+			t.Fatalf("should get %v but got %v", infra, got)
+		}
+	})
+
+	if _, err := New("xml", ""); err == nil {
+		t.Fatalf("should get error of unsupported output")
+	}
+}
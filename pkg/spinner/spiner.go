@@ -3,6 +3,8 @@ package spinner
 import (
 	"fmt"
 	"math/rand"
+	"os"
+	"sync"
 	"time"
 
 	"github.com/briandowns/spinner"
@@ -11,10 +13,15 @@ import (
 
 var s *spinner.Spinner
 
+// mux tasks could be run concurrently, e.g. functions of a project are deployed in parallel
+var mux sync.Mutex
+
 func init() {
 	style := spinner.CharSets[36]
 	interval := 100 * time.Millisecond
 	s = spinner.New(style, interval)
+	// progress goes to stderr, so that stdout only has output of command, e.g. fx list -o json | jq
+	s.Writer = os.Stderr
 }
 
 // Start spinner
@@ -29,10 +36,15 @@ func Start(task string) {
 		"white",
 	}
 
+	mux.Lock()
+	defer mux.Unlock()
+
 	rand.Seed(time.Now().UnixNano())
 	// nolint
 	s.Color(colors[rand.Intn(len(colors))])
+	s.Lock()
 	s.Prefix = task + " "
+	s.Unlock()
 	if s.Active() {
 		s.Restart()
 	} else {
@@ -42,8 +54,21 @@ func Start(task string) {
 
 // Stop spinner
 func Stop(task string, err error) {
+	mux.Lock()
+	defer mux.Unlock()
+
 	if err != nil {
-		fmt.Println(aurora.Red("\u2717"))
+		fmt.Fprintln(os.Stderr, aurora.Red("\u2717"))
 	}
 	s.Stop()
 }
+
+// Update task of spinner in progress, e.g. show the current step of task
+func Update(task string) {
+	mux.Lock()
+	defer mux.Unlock()
+
+	s.Lock()
+	s.Prefix = task + " "
+	s.Unlock()
+}
@@ -0,0 +1,216 @@
+package registry
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/docker/distribution/reference"
+	dockerTypes "github.com/docker/docker/api/types"
+	"github.com/metrue/fx/config"
+	"github.com/metrue/fx/pkg/failure"
+	"github.com/metrue/fx/utils"
+	"github.com/mitchellh/go-homedir"
+)
+
+// DockerHub key of Docker Hub in credentials store, the same as the one of docker cli
+const DockerHub = "https://index.docker.io/v1/"
+
+const storeFile = "registries.json"
+
+// credentials store in the format of ~/.docker/config.json
+type credentials struct {
+	Auths map[string]dockerTypes.AuthConfig `json:"auths"`
+}
+
+// Store credentials of registries, written by 'fx registry login' into ~/.fx/registries.json,
+// which is readable by owner only. Credentials in ~/.docker/config.json written by 'docker login'
+// are used when a registry is not logged in with fx, credential helpers of docker are not supported
+type Store struct {
+	mux          sync.Mutex
+	file         string
+	dockerConfig string
+}
+
+// LoadDefault load the credentials store in ~/.fx, or in the directory of $FX_CONFIG when it's set,
+// with the docker config in ~/.docker, or in $DOCKER_CONFIG when it's set
+func LoadDefault() (*Store, error) {
+	dir, err := homedir.Expand("~/.fx")
+	if err != nil {
+		return nil, err
+	}
+	if os.Getenv("FX_CONFIG") != "" {
+		dir = filepath.Dir(os.Getenv("FX_CONFIG"))
+	}
+	dockerDir, err := homedir.Expand("~/.docker")
+	if err != nil {
+		return nil, err
+	}
+	if os.Getenv("DOCKER_CONFIG") != "" {
+		dockerDir = os.Getenv("DOCKER_CONFIG")
+	}
+	return Load(dir, filepath.Join(dockerDir, "config.json"))
+}
+
+// Load the credentials store in dir, with credentials in dockerConfig as fallback
+func Load(dir string, dockerConfig string) (*Store, error) {
+	if err := utils.EnsureDir(dir); err != nil {
+		return nil, err
+	}
+	return &Store{file: filepath.Join(dir, storeFile), dockerConfig: dockerConfig}, nil
+}
+
+// Login save credentials of registry server
+func (s *Store) Login(server string, username string, password string) error {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	unlock, err := config.Lock(s.file)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	creds, err := read(s.file)
+	if err != nil {
+		return err
+	}
+	creds.Auths[Normalize(server)] = dockerTypes.AuthConfig{
+		Auth: base64.StdEncoding.EncodeToString([]byte(username + ":" + password)),
+	}
+	body, err := json.MarshalIndent(creds, "", "  ")
+	if err != nil {
+		return err
+	}
+	return config.WriteFile(s.file, body)
+}
+
+// Logout remove credentials of registry server
+func (s *Store) Logout(server string) error {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	unlock, err := config.Lock(s.file)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	creds, err := read(s.file)
+	if err != nil {
+		return err
+	}
+	key := Normalize(server)
+	if _, ok := creds.Auths[key]; !ok {
+		return failure.NotFound(fmt.Errorf("not logged in to %s", key))
+	}
+	delete(creds.Auths, key)
+	body, err := json.MarshalIndent(creds, "", "  ")
+	if err != nil {
+		return err
+	}
+	return config.WriteFile(s.file, body)
+}
+
+// Get credentials of registry server, an empty one is returned when there is none
+func (s *Store) Get(server string) (dockerTypes.AuthConfig, error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	key := Normalize(server)
+	for _, file := range []string{s.file, s.dockerConfig} {
+		creds, err := read(file)
+		if err != nil {
+			return dockerTypes.AuthConfig{}, err
+		}
+		for k, auth := range creds.Auths {
+			if Normalize(k) != key {
+				continue
+			}
+			if auth.Auth != "" && auth.Username == "" {
+				decoded, err := base64.StdEncoding.DecodeString(auth.Auth)
+				if err != nil {
+					return dockerTypes.AuthConfig{}, fmt.Errorf("invalid credentials of %s in %s: %v", k, file, err)
+				}
+				parts := strings.SplitN(string(decoded), ":", 2)
+				if len(parts) != 2 {
+					return dockerTypes.AuthConfig{}, fmt.Errorf("invalid credentials of %s in %s", k, file)
+				}
+				auth.Username, auth.Password = parts[0], parts[1]
+				auth.Auth = ""
+			}
+			auth.ServerAddress = key
+			return auth, nil
+		}
+	}
+	return dockerTypes.AuthConfig{ServerAddress: key}, nil
+}
+
+// EncodedAuth credentials of registry of image, encoded in the format of X-Registry-Auth header of Docker API
+func (s *Store) EncodedAuth(image string) (string, error) {
+	server, err := ServerOf(image)
+	if err != nil {
+		return "", err
+	}
+	auth, err := s.Get(server)
+	if err != nil {
+		return "", err
+	}
+	return Encode(auth)
+}
+
+// Encode credentials in the format of X-Registry-Auth header of Docker API
+func Encode(auth dockerTypes.AuthConfig) (string, error) {
+	body, err := json.Marshal(auth)
+	if err != nil {
+		return "", err
+	}
+	return base64.URLEncoding.EncodeToString(body), nil
+}
+
+// ServerOf registry server of image, e.g. 'registry.example.com:5000' of 'registry.example.com:5000/team/hello:latest',
+// DockerHub for an image without registry
+func ServerOf(image string) (string, error) {
+	named, err := reference.ParseNormalizedNamed(image)
+	if err != nil {
+		return "", fmt.Errorf("invalid image name %s: %v", image, err)
+	}
+	return Normalize(reference.Domain(named)), nil
+}
+
+// Normalize registry server, scheme and path of it are dropped, and all the names of Docker Hub are DockerHub
+func Normalize(server string) string {
+	s := server
+	if i := strings.Index(s, "://"); i >= 0 {
+		s = s[i+3:]
+	}
+	if i := strings.Index(s, "/"); i >= 0 {
+		s = s[:i]
+	}
+	switch s {
+	case "", "docker.io", "index.docker.io", "registry-1.docker.io":
+		return DockerHub
+	}
+	return s
+}
+
+func read(file string) (*credentials, error) {
+	creds := &credentials{Auths: map[string]dockerTypes.AuthConfig{}}
+	body, err := ioutil.ReadFile(file)
+	if os.IsNotExist(err) {
+		return creds, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(body, creds); err != nil {
+		return nil, fmt.Errorf("invalid credentials store %s: %v", file, err)
+	}
+	if creds.Auths == nil {
+		creds.Auths = map[string]dockerTypes.AuthConfig{}
+	}
+	return creds, nil
+}
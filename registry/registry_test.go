@@ -0,0 +1,181 @@
+package registry
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	dockerTypes "github.com/docker/docker/api/types"
+	"github.com/metrue/fx/pkg/failure"
+)
+
+func TestServerOf(t *testing.T) {
+	cases := map[string]string{
+		"hello":                                   DockerHub,
+		"metrue/hello:latest":                     DockerHub,
+		"docker.io/metrue/hello":                  DockerHub,
+		"registry.example.com/team/hello":         "registry.example.com",
+		"localhost:5000/hello:v1":                 "localhost:5000",
+		"127.0.0.1:5000/team/hello@sha256:" + sha: "127.0.0.1:5000",
+	}
+	for image, expect := range cases {
+		server, err := ServerOf(image)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if server != expect {
+			t.Fatalf("should get %s but got %s", expect, server)
+		}
+	}
+	if _, err := ServerOf("Invalid Image"); err == nil {
+		t.Fatalf("should get error of invalid image name")
+	}
+	if Normalize("https://registry.example.com/v2/") != "registry.example.com" {
+		t.Fatalf("should get %s but got %s", "registry.example.com", Normalize("https://registry.example.com/v2/"))
+	}
+}
+
+const sha = "0000000000000000000000000000000000000000000000000000000000000000"
+
+func TestStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "fx-registry")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	// credentials written by docker login
+	dockerConfig := filepath.Join(dir, "docker", "config.json")
+	if err := os.MkdirAll(filepath.Dir(dockerConfig), 0700); err != nil {
+		t.Fatal(err)
+	}
+	body, _ := json.Marshal(map[string]interface{}{
+		"auths": map[string]interface{}{
+			"https://index.docker.io/v1/": map[string]string{"auth": base64.StdEncoding.EncodeToString([]byte("hub-user:hub-pass"))},
+			"registry.example.com":        map[string]string{"auth": base64.StdEncoding.EncodeToString([]byte("docker-user:docker-pass"))},
+		},
+		"credsStore": "desktop",
+	})
+	if err := ioutil.WriteFile(dockerConfig, body, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	store, err := Load(filepath.Join(dir, "fx"), dockerConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	auth, err := store.Get("docker.io")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if auth.Username != "hub-user" || auth.Password != "hub-pass" || auth.ServerAddress != DockerHub {
+		t.Fatalf("should get credentials of Docker Hub but got %v", auth)
+	}
+
+	if err := store.Login("https://registry.example.com", "fx-user", "fx-pass"); err != nil {
+		t.Fatal(err)
+	}
+	auth, err = store.Get("registry.example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if auth.Username != "fx-user" || auth.Password != "fx-pass" {
+		t.Fatalf("should get credentials of fx login but got %v", auth)
+	}
+	info, err := os.Stat(filepath.Join(dir, "fx", storeFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Mode().Perm() != 0600 {
+		t.Fatalf("should get %v but got %v", os.FileMode(0600), info.Mode().Perm())
+	}
+
+	encoded, err := store.EncodedAuth("registry.example.com/team/hello:latest")
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded, err := base64.URLEncoding.DecodeString(encoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got dockerTypes.AuthConfig
+	if err := json.Unmarshal(decoded, &got); err != nil {
+		t.Fatal(err)
+	}
+	if got.Username != "fx-user" || got.Password != "fx-pass" || got.ServerAddress != "registry.example.com" {
+		t.Fatalf("should get credentials of fx login but got %v", got)
+	}
+
+	if err := store.Logout("registry.example.com"); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.Logout("registry.example.com"); !failure.IsNotFound(err) {
+		t.Fatalf("should get not found error when not logged in but got %v", err)
+	}
+	auth, err = store.Get("registry.example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if auth.Username != "docker-user" {
+		t.Fatalf("should get credentials of docker login but got %v", auth)
+	}
+
+	auth, err = store.Get("localhost:5000")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if auth.Username != "" || auth.ServerAddress != "localhost:5000" {
+		t.Fatalf("should get empty credentials but got %v", auth)
+	}
+}
+
+func TestConcurrentLogins(t *testing.T) {
+	dir, err := ioutil.TempDir("", "fx-registry")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	// every store is loaded separately like it's in its own fx process
+	const n = 10
+	var wg sync.WaitGroup
+	errs := make(chan error, n)
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			s, err := Load(dir, filepath.Join(dir, "docker.json"))
+			if err != nil {
+				errs <- err
+				return
+			}
+			if err := s.Login(fmt.Sprintf("registry-%d.example.com", i), "fx", "s3cr3t"); err != nil {
+				errs <- err
+			}
+		}(i)
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Fatal(err)
+	}
+
+	s, err := Load(dir, filepath.Join(dir, "docker.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < n; i++ {
+		auth, err := s.Get(fmt.Sprintf("registry-%d.example.com", i))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if auth.Username != "fx" {
+			t.Fatalf("should keep credentials of registry-%d.example.com", i)
+		}
+	}
+}
@@ -0,0 +1,99 @@
+package registry
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Verify check credentials with registry server by its /v2/ endpoint, with basic auth or a bearer token
+// requested from its token service. https is used unless server is given with 'http://' scheme
+func Verify(ctx context.Context, server string, username string, password string) error {
+	endpoint := "https://" + Normalize(server) + "/v2/"
+	if Normalize(server) == DockerHub {
+		endpoint = "https://registry-1.docker.io/v2/"
+	} else if strings.HasPrefix(server, "http://") {
+		endpoint = "http://" + Normalize(server) + "/v2/"
+	}
+	client := &http.Client{Timeout: 30 * time.Second}
+
+	resp, err := get(ctx, client, endpoint, "", "")
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	if resp.StatusCode == http.StatusOK {
+		return nil
+	}
+	if resp.StatusCode != http.StatusUnauthorized {
+		return fmt.Errorf("could not reach registry %s: %s", endpoint, resp.Status)
+	}
+
+	challenge := resp.Header.Get("WWW-Authenticate")
+	scheme, params := parseChallenge(challenge)
+	switch strings.ToLower(scheme) {
+	case "basic":
+		resp, err = get(ctx, client, endpoint, username, password)
+	case "bearer":
+		realm, err := url.Parse(params["realm"])
+		if err != nil || params["realm"] == "" {
+			return fmt.Errorf("invalid challenge of registry %s: %s", endpoint, challenge)
+		}
+		query := realm.Query()
+		query.Set("account", username)
+		for _, k := range []string{"service", "scope"} {
+			if params[k] != "" {
+				query.Set(k, params[k])
+			}
+		}
+		realm.RawQuery = query.Encode()
+		resp, err = get(ctx, client, realm.String(), username, password)
+		if err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("unsupported challenge of registry %s: %s", endpoint, challenge)
+	}
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	if resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden {
+		return fmt.Errorf("login to %s failed: invalid username or password", Normalize(server))
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("login to %s failed: %s", Normalize(server), resp.Status)
+	}
+	return nil
+}
+
+func get(ctx context.Context, client *http.Client, url string, username string, password string) (*http.Response, error) {
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	if username != "" || password != "" {
+		req.SetBasicAuth(username, password)
+	}
+	return client.Do(req.WithContext(ctx))
+}
+
+// parseChallenge parse WWW-Authenticate header, e.g. 'Bearer realm="https://auth.docker.io/token",service="registry.docker.io"'
+func parseChallenge(header string) (scheme string, params map[string]string) {
+	params = map[string]string{}
+	parts := strings.SplitN(strings.TrimSpace(header), " ", 2)
+	scheme = parts[0]
+	if len(parts) < 2 {
+		return scheme, params
+	}
+	for _, kv := range strings.Split(parts[1], ",") {
+		pair := strings.SplitN(strings.TrimSpace(kv), "=", 2)
+		if len(pair) == 2 {
+			params[strings.ToLower(pair[0])] = strings.Trim(pair[1], `"`)
+		}
+	}
+	return scheme, params
+}
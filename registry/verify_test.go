@@ -0,0 +1,55 @@
+package registry
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// fakeRegistry a registry stand-in accepts user:pass, with basic auth, or with a bearer token of its token service
+func fakeRegistry(bearer bool) *httptest.Server {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		username, password, ok := r.BasicAuth()
+		valid := ok && username == "user" && password == "pass"
+		switch r.URL.Path {
+		case "/v2/":
+			if bearer {
+				if r.Header.Get("Authorization") == "Bearer token" {
+					return
+				}
+				w.Header().Set("WWW-Authenticate", fmt.Sprintf(`Bearer realm="%s/token",service="fake"`, server.URL))
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			if !valid {
+				w.Header().Set("WWW-Authenticate", `Basic realm="fake"`)
+				w.WriteHeader(http.StatusUnauthorized)
+			}
+		case "/token":
+			if !valid || r.URL.Query().Get("service") != "fake" || r.URL.Query().Get("account") != "user" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			fmt.Fprint(w, `{"token":"token"}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	return server
+}
+
+func TestVerify(t *testing.T) {
+	for _, bearer := range []bool{false, true} {
+		server := fakeRegistry(bearer)
+		if err := Verify(context.Background(), server.URL, "user", "pass"); err != nil {
+			t.Fatal(err)
+		}
+		if err := Verify(context.Background(), server.URL, "user", "wrong"); err == nil {
+			t.Fatalf("should get error of invalid password")
+		}
+		server.Close()
+	}
+}
@@ -0,0 +1,237 @@
+package secret
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"sync"
+
+	"github.com/metrue/fx/config"
+	"github.com/metrue/fx/pkg/failure"
+	"github.com/metrue/fx/utils"
+	"github.com/mitchellh/go-homedir"
+)
+
+const (
+	storeFile = "secrets"
+	keyFile   = "secrets.key"
+
+	// KeyEnv environment variable of base64 encoded AES-256 key of store
+	KeyEnv = "FX_SECRET_KEY"
+	// KeyFileEnv environment variable of path of key file, e.g. in a keyring or a mounted volume
+	KeyFileEnv = "FX_SECRET_KEY_FILE"
+)
+
+var namePattern = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+// Store secrets encrypted at rest with AES-GCM. The key is given by $FX_SECRET_KEY, or read from
+// $FX_SECRET_KEY_FILE, so that it's kept out of the store. Without them, a key is generated on first use
+// and kept next to the secrets, readable by owner only
+type Store struct {
+	mux     sync.Mutex
+	dir     string
+	key     string
+	keyFile string
+}
+
+// LoadDefault load the secret store in ~/.fx, or in the directory of $FX_CONFIG when it's set
+func LoadDefault() (*Store, error) {
+	dir, err := homedir.Expand("~/.fx")
+	if err != nil {
+		return nil, err
+	}
+	if os.Getenv("FX_CONFIG") != "" {
+		dir = filepath.Dir(os.Getenv("FX_CONFIG"))
+	}
+	return Load(dir)
+}
+
+// Load the secret store in dir
+func Load(dir string) (*Store, error) {
+	if err := utils.EnsureDir(dir); err != nil {
+		return nil, err
+	}
+	keyFile := filepath.Join(dir, keyFile)
+	if os.Getenv(KeyFileEnv) != "" {
+		path, err := homedir.Expand(os.Getenv(KeyFileEnv))
+		if err != nil {
+			return nil, err
+		}
+		keyFile = path
+	}
+	return &Store{dir: dir, key: os.Getenv(KeyEnv), keyFile: keyFile}, nil
+}
+
+// Set a secret
+func (s *Store) Set(name string, value string) error {
+	if !namePattern.MatchString(name) {
+		return fmt.Errorf("invalid secret name %s, it should match %s", name, namePattern.String())
+	}
+
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	unlock, err := config.Lock(filepath.Join(s.dir, storeFile))
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	secrets, err := s.read()
+	if err != nil {
+		return err
+	}
+	secrets[name] = value
+	return s.write(secrets)
+}
+
+// Remove a secret
+func (s *Store) Remove(name string) error {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	unlock, err := config.Lock(filepath.Join(s.dir, storeFile))
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	secrets, err := s.read()
+	if err != nil {
+		return err
+	}
+	if _, ok := secrets[name]; !ok {
+		return failure.NotFound(fmt.Errorf("no such secret %s", name))
+	}
+	delete(secrets, name)
+	return s.write(secrets)
+}
+
+// List names of secrets, values are never listed
+func (s *Store) List() ([]string, error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	secrets, err := s.read()
+	if err != nil {
+		return nil, err
+	}
+	names := []string{}
+	for name := range secrets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// Get secrets with names
+func (s *Store) Get(names ...string) (map[string]string, error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	secrets, err := s.read()
+	if err != nil {
+		return nil, err
+	}
+	values := map[string]string{}
+	for _, name := range names {
+		value, ok := secrets[name]
+		if !ok {
+			return nil, failure.NotFound(fmt.Errorf("no such secret %s, please set it with 'fx secret set' first", name))
+		}
+		values[name] = value
+	}
+	return values, nil
+}
+
+func (s *Store) read() (map[string]string, error) {
+	secrets := map[string]string{}
+	body, err := ioutil.ReadFile(filepath.Join(s.dir, storeFile))
+	if os.IsNotExist(err) {
+		return secrets, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	gcm, err := s.cipher()
+	if err != nil {
+		return nil, err
+	}
+	if len(body) < gcm.NonceSize() {
+		return nil, fmt.Errorf("secret store is corrupted")
+	}
+	nonce, ciphertext := body[:gcm.NonceSize()], body[gcm.NonceSize():]
+	plaintext, err := gcm.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not decrypt secret store: %v", err)
+	}
+	if err := json.Unmarshal(plaintext, &secrets); err != nil {
+		return nil, err
+	}
+	return secrets, nil
+}
+
+func (s *Store) write(secrets map[string]string) error {
+	plaintext, err := json.Marshal(secrets)
+	if err != nil {
+		return err
+	}
+	gcm, err := s.cipher()
+	if err != nil {
+		return err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return err
+	}
+	body := gcm.Seal(nonce, nonce, plaintext, nil)
+	// a crash while writing never truncates the store
+	return config.WriteFile(filepath.Join(s.dir, storeFile), body)
+}
+
+// cipher of store, a random key is generated into key file when there is not one
+func (s *Store) cipher() (cipher.AEAD, error) {
+	key, err := s.readKey()
+	if err != nil {
+		return nil, err
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func (s *Store) readKey() ([]byte, error) {
+	if s.key != "" {
+		key, err := base64.StdEncoding.DecodeString(s.key)
+		if err != nil || len(key) != 32 {
+			return nil, failure.Config(fmt.Errorf("%s should be a base64 encoded 32 bytes key", KeyEnv))
+		}
+		return key, nil
+	}
+
+	key, err := ioutil.ReadFile(s.keyFile)
+	if os.IsNotExist(err) {
+		key = make([]byte, 32)
+		if _, err := io.ReadFull(rand.Reader, key); err != nil {
+			return nil, err
+		}
+		if err := utils.EnsureDir(filepath.Dir(s.keyFile)); err != nil {
+			return nil, err
+		}
+		if err := config.WriteFile(s.keyFile, key); err != nil {
+			return nil, err
+		}
+		return key, nil
+	}
+	return key, err
+}
@@ -0,0 +1,149 @@
+package secret
+
+import (
+	"bytes"
+	"encoding/base64"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "fx-secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	s, err := Load(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Set("DB_PASSWORD", "s3cr3t"); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Set("API_KEY", "k3y"); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Set("bad-name", "value"); err == nil {
+		t.Fatalf("should get error with invalid secret name")
+	}
+
+	body, err := ioutil.ReadFile(filepath.Join(dir, storeFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Contains(body, []byte("s3cr3t")) {
+		t.Fatalf("should encrypt secrets at rest")
+	}
+	stat, err := os.Stat(filepath.Join(dir, keyFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stat.Mode().Perm() != 0600 {
+		t.Fatalf("should get %v but got %v", os.FileMode(0600), stat.Mode().Perm())
+	}
+
+	// load again to make sure secrets are persisted
+	s, err = Load(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	names, err := s.List()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(names, []string{"API_KEY", "DB_PASSWORD"}) {
+		t.Fatalf("should get %v but got %v", []string{"API_KEY", "DB_PASSWORD"}, names)
+	}
+	values, err := s.Get("DB_PASSWORD")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if values["DB_PASSWORD"] != "s3cr3t" {
+		t.Fatalf("should get %s but got %s", "s3cr3t", values["DB_PASSWORD"])
+	}
+
+	if err := s.Remove("DB_PASSWORD"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Get("DB_PASSWORD"); err == nil {
+		t.Fatalf("should get error after secret removed")
+	}
+	if err := s.Remove("DB_PASSWORD"); err == nil {
+		t.Fatalf("should get error when removing a secret not existed")
+	}
+}
+
+func TestStoreKey(t *testing.T) {
+	dir, err := ioutil.TempDir("", "fx-secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	t.Run("key file", func(t *testing.T) {
+		store := filepath.Join(dir, "key-file")
+		keyring := filepath.Join(dir, "keyring", "fx.key")
+		os.Setenv(KeyFileEnv, keyring)
+		defer os.Unsetenv(KeyFileEnv)
+
+		s, err := Load(store)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := s.Set("DB_PASSWORD", "s3cr3t"); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := os.Stat(keyring); err != nil {
+			t.Fatalf("should generate key into key file: %v", err)
+		}
+		if _, err := os.Stat(filepath.Join(store, keyFile)); err == nil {
+			t.Fatalf("should not keep key in store")
+		}
+
+		os.Unsetenv(KeyFileEnv)
+		s, err = Load(store)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := s.Get("DB_PASSWORD"); err == nil {
+			t.Fatalf("should not decrypt store without its key")
+		}
+	})
+
+	t.Run("key", func(t *testing.T) {
+		store := filepath.Join(dir, "key")
+		os.Setenv(KeyEnv, base64.StdEncoding.EncodeToString(bytes.Repeat([]byte("k"), 32)))
+		defer os.Unsetenv(KeyEnv)
+
+		s, err := Load(store)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := s.Set("DB_PASSWORD", "s3cr3t"); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := os.Stat(filepath.Join(store, keyFile)); err == nil {
+			t.Fatalf("should not keep key in store")
+		}
+		values, err := s.Get("DB_PASSWORD")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if values["DB_PASSWORD"] != "s3cr3t" {
+			t.Fatalf("should get %s but got %s", "s3cr3t", values["DB_PASSWORD"])
+		}
+
+		os.Setenv(KeyEnv, "short")
+		s, err = Load(store)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := s.Get("DB_PASSWORD"); err == nil {
+			t.Fatalf("should get error with invalid key")
+		}
+	})
+}
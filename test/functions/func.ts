@@ -0,0 +1,6 @@
+import { ServerRequest } from "https://deno.land/std@0.50.0/http/server.ts";
+
+// ctx.request is the ServerRequest of std http server, ctx.body is the body of response
+export default async function fx(ctx: { request: ServerRequest; body: string }) {
+  ctx.body = "hello world";
+}
@@ -0,0 +1,21 @@
+package types
+
+// Infra an infrastructure services are deployed to
+type Infra struct {
+	Name    string `json:"name" yaml:"name"`
+	Type    string `json:"type" yaml:"type"`
+	Current bool   `json:"current" yaml:"current"`
+	// Host host of Docker engine
+	Host string `json:"host,omitempty" yaml:"host,omitempty"`
+	// Endpoint endpoint of Docker engine, or unix socket of Podman service
+	Endpoint string `json:"endpoint,omitempty" yaml:"endpoint,omitempty"`
+	User     string `json:"user,omitempty" yaml:"user,omitempty"`
+	// Kubeconfig path of kubeconfig file of Kubernetes cluster
+	Kubeconfig string `json:"kubeconfig,omitempty" yaml:"kubeconfig,omitempty"`
+	Namespace  string `json:"namespace,omitempty" yaml:"namespace,omitempty"`
+	Registry   string `json:"registry,omitempty" yaml:"registry,omitempty"`
+	// Server API server of Kubernetes cluster
+	Server string `json:"server,omitempty" yaml:"server,omitempty"`
+	// Settings all settings of infrastructure, credentials in them are redacted
+	Settings map[string]string `json:"settings,omitempty" yaml:"settings,omitempty"`
+}
@@ -0,0 +1,10 @@
+package types
+
+// LogOptions options to fetch logs of a service
+// Since can be a relative duration (e.g. 10m) or a RFC3339 timestamp
+// Tail is the number of lines to show from the end of logs, or "all"
+type LogOptions struct {
+	Follow bool
+	Since  string
+	Tail   string
+}
@@ -0,0 +1,66 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	units "github.com/docker/go-units"
+)
+
+// restartPolicies restart policies of container, the same as '--restart' of docker run
+var restartPolicies = []string{"no", "always", "on-failure", "unless-stopped"}
+
+// RestartPolicy restart policy of a service,
+// MaximumRetryCount is the max times to restart with on-failure policy, 0 means no limit
+type RestartPolicy struct {
+	Name              string
+	MaximumRetryCount int
+}
+
+// ParseMemory parse memory limit in human readable format, e.g. 512m, 1g, into bytes
+func ParseMemory(s string) (int64, error) {
+	n, err := units.RAMInBytes(s)
+	if err != nil || n <= 0 {
+		return 0, fmt.Errorf("invalid memory %s, it should be a positive size like 512m or 1g", s)
+	}
+	return n, nil
+}
+
+// ParseCPUs parse count of CPUs, e.g. 0.5, 2
+func ParseCPUs(s string) (float64, error) {
+	n, err := strconv.ParseFloat(s, 64)
+	if err != nil || n <= 0 {
+		return 0, fmt.Errorf("invalid cpus %s, it should be a positive number like 0.5 or 2", s)
+	}
+	return n, nil
+}
+
+// ParseRestartPolicy parse restart policy in <policy>[:max-retries] format, max-retries is for on-failure only
+func ParseRestartPolicy(s string) (RestartPolicy, error) {
+	parts := strings.SplitN(s, ":", 2)
+	policy := RestartPolicy{Name: parts[0]}
+	valid := false
+	for _, name := range restartPolicies {
+		valid = valid || policy.Name == name
+	}
+	if !valid {
+		return RestartPolicy{}, fmt.Errorf("invalid restart policy %s, it should be one of %s", s, strings.Join(restartPolicies, ", "))
+	}
+	if len(parts) == 2 {
+		n, err := strconv.Atoi(parts[1])
+		if policy.Name != "on-failure" || err != nil || n < 0 {
+			return RestartPolicy{}, fmt.Errorf("invalid restart policy %s, max-retries is a positive number for on-failure only", s)
+		}
+		policy.MaximumRetryCount = n
+	}
+	return policy, nil
+}
+
+// ParseHealthPath check HTTP path to check health of service
+func ParseHealthPath(s string) (string, error) {
+	if !strings.HasPrefix(s, "/") || strings.ContainsAny(s, " \t\n'\"") {
+		return "", fmt.Errorf("invalid health path %s, it should be a URL path like /health", s)
+	}
+	return s, nil
+}
@@ -1,5 +1,10 @@
 package types
 
+import (
+	"sort"
+	"time"
+)
+
 // ServiceRunOptions a service to start options
 type ServiceRunOptions struct {
 	Image string
@@ -11,10 +16,74 @@ const DefaultHost = "0.0.0.0"
 
 // Service instance of a service
 type Service struct {
-	ID    string `json:"id"`
-	Host  string `json:"host"`
-	Port  int    `json:"port"`
-	State string `json:"state"`
-	Name  string `json:"name"`
-	Image string `json:"image"`
+	ID      string    `json:"id" yaml:"id"`
+	Host    string    `json:"host" yaml:"host"`
+	Port    int       `json:"port" yaml:"port"`
+	State   string    `json:"state" yaml:"state"`
+	Name    string    `json:"name" yaml:"name"`
+	Image   string    `json:"image" yaml:"image"`
+	Created time.Time `json:"created" yaml:"created"`
+
+	Replicas      int `json:"replicas,omitempty" yaml:"replicas,omitempty"`
+	ReadyReplicas int `json:"ready_replicas,omitempty" yaml:"ready_replicas,omitempty"`
+}
+
+// DeployOptions options of deploying a service
+type DeployOptions struct {
+	// Replicas count of service instances, used by Kubernetes only, 0 means the default
+	Replicas int32
+	// Env environment variables of service
+	Env map[string]string
+	// Secrets environment variables of service from secret store, they override Env with the same name,
+	// values of them should never be printed
+	Secrets map[string]string
+	// Memory memory limit of service instance in bytes, 0 means no limit
+	Memory int64
+	// CPUs count of CPUs service instance could use, e.g. 0.5, 0 means no limit
+	CPUs float64
+	// Restart restart policy in <policy>[:max-retries] format, see ParseRestartPolicy, used by Docker only
+	Restart string
+	// HealthPath HTTP path on container expose port to check health of service, no health check when it's empty
+	HealthPath string
+}
+
+// EnvList environment variables and secrets in KEY=VALUE format, sorted by key
+func (o DeployOptions) EnvList() []string {
+	merged := map[string]string{}
+	for k, v := range o.Env {
+		merged[k] = v
+	}
+	for k, v := range o.Secrets {
+		merged[k] = v
+	}
+	keys := make([]string, 0, len(merged))
+	for k := range merged {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	env := make([]string, 0, len(keys))
+	for _, k := range keys {
+		env = append(env, k+"="+merged[k])
+	}
+	return env
+}
+
+// Resource a resource created by fx for a service, e.g. a container or a Kubernetes ConfigMap
+type Resource struct {
+	Kind    string `json:"kind"`
+	Name    string `json:"name"`
+	Service string `json:"service"`
+	// Unlabeled it's not labeled as created by fx, e.g. created by an earlier fx, or by someone else with the same name
+	Unlabeled bool `json:"unlabeled,omitempty" yaml:"unlabeled,omitempty"`
+}
+
+// Labeled resources labeled as created by fx, the unlabeled ones are removed only when they're included explicitly
+func Labeled(resources []Resource) []Resource {
+	labeled := []Resource{}
+	for _, r := range resources {
+		if !r.Unlabeled {
+			labeled = append(labeled, r)
+		}
+	}
+	return labeled
 }
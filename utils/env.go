@@ -0,0 +1,73 @@
+package utils
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var envKeyPattern = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+// ParseEnv make ["A=1", "B=x=y"] to be {"A": "1", "B": "x=y"}
+func ParseEnv(pairs []string) (map[string]string, error) {
+	env := map[string]string{}
+	for _, pair := range pairs {
+		key, value, err := parseEnvPair(pair)
+		if err != nil {
+			return nil, err
+		}
+		env[key] = value
+	}
+	return env, nil
+}
+
+// ParseEnvFile parse environment variables from a dotenv file, one KEY=VALUE a line,
+// blank lines and lines start with # are ignored, value could be quoted and line could start with "export "
+func ParseEnvFile(file string) (map[string]string, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	env := map[string]string{}
+	scanner := bufio.NewScanner(f)
+	n := 0
+	for scanner.Scan() {
+		n++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		line = strings.TrimPrefix(line, "export ")
+		key, value, err := parseEnvPair(line)
+		if err != nil {
+			return nil, fmt.Errorf("%s:%d: %v", file, n, err)
+		}
+		if len(value) >= 2 && value[0] == '"' && value[len(value)-1] == '"' {
+			if unquoted, err := strconv.Unquote(value); err == nil {
+				value = unquoted
+			}
+		} else if len(value) >= 2 && value[0] == '\'' && value[len(value)-1] == '\'' {
+			value = value[1 : len(value)-1]
+		}
+		env[key] = value
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return env, nil
+}
+
+func parseEnvPair(pair string) (string, string, error) {
+	subs := strings.SplitN(pair, "=", 2)
+	key := strings.TrimSpace(subs[0])
+	if len(subs) != 2 || !envKeyPattern.MatchString(key) {
+		// do not print the pair, value of it could be a secret
+		return "", "", fmt.Errorf("invalid environment variable %s, it should be in KEY=VALUE format", key)
+	}
+	return key, strings.TrimSpace(subs[1]), nil
+}
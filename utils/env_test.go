@@ -0,0 +1,52 @@
+package utils
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseEnv(t *testing.T) {
+	env, err := ParseEnv([]string{"A=1", "B=x=y", "C="})
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{"A": "1", "B": "x=y", "C": ""}, env)
+
+	_, err = ParseEnv([]string{"A"})
+	assert.NotNil(t, err)
+	_, err = ParseEnv([]string{"1A=1"})
+	assert.NotNil(t, err)
+}
+
+func TestParseEnvFile(t *testing.T) {
+	f, err := ioutil.TempFile("", "fx-env")
+	assert.Nil(t, err)
+	defer os.Remove(f.Name())
+
+	body := `# comment
+A=1
+
+export B=2
+C="hello world\n"
+D='single quoted'
+E=x=y
+`
+	_, err = f.WriteString(body)
+	assert.Nil(t, err)
+	f.Close()
+
+	env, err := ParseEnvFile(f.Name())
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{
+		"A": "1",
+		"B": "2",
+		"C": "hello world\n",
+		"D": "single quoted",
+		"E": "x=y",
+	}, env)
+
+	assert.Nil(t, ioutil.WriteFile(f.Name(), []byte("A=1\nbad line\n"), 0644))
+	_, err = ParseEnvFile(f.Name())
+	assert.NotNil(t, err)
+}
@@ -262,6 +262,11 @@ func GetLangFromFileName(fileName string) (lang string) {
 		".java": "java",
 		".d":    "d",
 		".rs":   "rust",
+		".ts":   "deno",
+		".lua":  "lua",
+		".pl":   "perl",
+		".cs":   "csharp",
+		".kt":   "kotlin",
 	}
 	return extLangMap[filepath.Ext(fileName)]
 }